@@ -0,0 +1,290 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/peppage/foursquarego"
+)
+
+// command is a subcommand of fsq. params returns a pointer to the params
+// struct its flags are bound to, nil when it has none. rows turns the
+// result of call into table and csv rows, nil when only json is written.
+type command struct {
+	name    string
+	args    string
+	summary string
+	params  func() interface{}
+	call    func(c *foursquarego.Client, params interface{}, args []string) (interface{}, *http.Response, error)
+	rows    func(v interface{}) ([]string, [][]string)
+}
+
+var commands = []command{
+	{
+		name:    "search",
+		summary: "Search for venues",
+		params:  func() interface{} { return new(foursquarego.VenueSearchParams) },
+		call: func(c *foursquarego.Client, params interface{}, args []string) (interface{}, *http.Response, error) {
+			return c.Venues.Search(params.(*foursquarego.VenueSearchParams))
+		},
+		rows: func(v interface{}) ([]string, [][]string) {
+			return venueRows(v.([]foursquarego.Venue))
+		},
+	},
+	{
+		name:    "explore",
+		summary: "Get recommended venues",
+		params:  func() interface{} { return new(foursquarego.VenueExploreParams) },
+		call: func(c *foursquarego.Client, params interface{}, args []string) (interface{}, *http.Response, error) {
+			return c.Venues.Explore(params.(*foursquarego.VenueExploreParams))
+		},
+		rows: exploreRows,
+	},
+	{
+		name:    "suggest",
+		summary: "Complete a partial venue name",
+		params:  func() interface{} { return new(foursquarego.VenueSuggestParams) },
+		call: func(c *foursquarego.Client, params interface{}, args []string) (interface{}, *http.Response, error) {
+			return c.Venues.SuggestCompletion(params.(*foursquarego.VenueSuggestParams))
+		},
+		rows: miniVenueRows,
+	},
+	{
+		name:    "trending",
+		summary: "Get venues with the most people checked in",
+		params:  func() interface{} { return new(foursquarego.VenueTrendingParams) },
+		call: func(c *foursquarego.Client, params interface{}, args []string) (interface{}, *http.Response, error) {
+			return c.Venues.Trending(params.(*foursquarego.VenueTrendingParams))
+		},
+		rows: func(v interface{}) ([]string, [][]string) {
+			return venueRows(v.([]foursquarego.Venue))
+		},
+	},
+	{
+		name:    "details",
+		args:    "<venue id>",
+		summary: "Get a venue",
+		call: func(c *foursquarego.Client, params interface{}, args []string) (interface{}, *http.Response, error) {
+			return c.Venues.Details(args[0])
+		},
+		rows: func(v interface{}) ([]string, [][]string) {
+			return venueRows([]foursquarego.Venue{*v.(*foursquarego.Venue)})
+		},
+	},
+	{
+		name:    "photos",
+		args:    "<venue id>",
+		summary: "Get the photos of a venue",
+		params:  func() interface{} { return new(foursquarego.VenuePhotosParams) },
+		call: func(c *foursquarego.Client, params interface{}, args []string) (interface{}, *http.Response, error) {
+			p := params.(*foursquarego.VenuePhotosParams)
+			p.VenueID = args[0]
+			return c.Venues.Photos(p)
+		},
+		rows: photoRows,
+	},
+	{
+		name:    "tips",
+		args:    "<venue id>",
+		summary: "Get the tips of a venue",
+		params:  func() interface{} { return new(foursquarego.VenueTipsParams) },
+		call: func(c *foursquarego.Client, params interface{}, args []string) (interface{}, *http.Response, error) {
+			p := params.(*foursquarego.VenueTipsParams)
+			p.VenueID = args[0]
+			return c.Venues.Tips(p)
+		},
+		rows: tipRows,
+	},
+	{
+		name:    "hours",
+		args:    "<venue id>",
+		summary: "Get the hours of a venue",
+		call: func(c *foursquarego.Client, params interface{}, args []string) (interface{}, *http.Response, error) {
+			return c.Venues.Hours(args[0])
+		},
+		rows: hoursRows,
+	},
+	{
+		name:    "menu",
+		args:    "<venue id>",
+		summary: "Get the menu of a venue",
+		call: func(c *foursquarego.Client, params interface{}, args []string) (interface{}, *http.Response, error) {
+			return c.Venues.Menu(args[0])
+		},
+		rows: menuRows,
+	},
+	{
+		name:    "categories",
+		summary: "Get the venue category tree",
+		call: func(c *foursquarego.Client, params interface{}, args []string) (interface{}, *http.Response, error) {
+			return c.Venues.Categories()
+		},
+		rows: categoryRows,
+	},
+	{
+		name:    "raw",
+		args:    "<path>",
+		summary: "GET any v2 path, venues/categories for example",
+		call: func(c *foursquarego.Client, params interface{}, args []string) (interface{}, *http.Response, error) {
+			path := strings.TrimPrefix(strings.TrimPrefix(args[0], "/"), "v2/")
+			response, resp, err := c.RawRequest(path)
+			if err != nil {
+				return nil, resp, err
+			}
+			return response.Response, resp, nil
+		},
+	},
+}
+
+func findCommand(name string) *command {
+	for i := range commands {
+		if commands[i].name == name {
+			return &commands[i]
+		}
+	}
+	return nil
+}
+
+var venueHeader = []string{"id", "name", "category", "address", "lat", "lng", "distance"}
+
+func venueRows(venues []foursquarego.Venue) ([]string, [][]string) {
+	rows := make([][]string, len(venues))
+	for i, v := range venues {
+		rows[i] = venueRow(v)
+	}
+	return venueHeader, rows
+}
+
+func venueRow(v foursquarego.Venue) []string {
+	category := ""
+	if c := v.PrimaryCategory(); c != nil {
+		category = c.Name
+	}
+	l := v.Location
+	return []string{
+		v.ID,
+		v.Name,
+		category,
+		strings.Join(l.FormattedAddress, ", "),
+		strconv.FormatFloat(l.Lat, 'f', -1, 64),
+		strconv.FormatFloat(l.Lng, 'f', -1, 64),
+		strconv.Itoa(l.Distance),
+	}
+}
+
+func exploreRows(v interface{}) ([]string, [][]string) {
+	var rows [][]string
+	for _, g := range v.(*foursquarego.VenueExploreResp).Groups {
+		for _, item := range g.Items {
+			row := venueRow(item.Venue)
+			rows = append(rows, append([]string{g.Name}, row...))
+		}
+	}
+	return append([]string{"group"}, venueHeader...), rows
+}
+
+func miniVenueRows(v interface{}) ([]string, [][]string) {
+	venues := v.([]foursquarego.MiniVenue)
+	rows := make([][]string, len(venues))
+	for i, m := range venues {
+		rows[i] = venueRow(foursquarego.Venue{ID: m.ID, Name: m.Name, Categories: m.Category, Location: m.Location})
+	}
+	return venueHeader, rows
+}
+
+func photoRows(v interface{}) ([]string, [][]string) {
+	var rows [][]string
+	for _, p := range v.(*foursquarego.PhotoGrouping).Items {
+		rows = append(rows, []string{
+			p.ID,
+			formatTime(p.CreatedAt.Time),
+			strconv.Itoa(p.Width),
+			strconv.Itoa(p.Height),
+			userName(p.User),
+			p.Prefix + "original" + p.Suffix,
+		})
+	}
+	return []string{"id", "created", "width", "height", "user", "url"}, rows
+}
+
+func tipRows(v interface{}) ([]string, [][]string) {
+	var rows [][]string
+	for _, t := range v.([]foursquarego.Tip) {
+		rows = append(rows, []string{
+			t.ID,
+			formatTime(t.CreatedAt.Time),
+			strconv.Itoa(t.AgreeCount),
+			userName(t.User),
+			t.Text,
+		})
+	}
+	return []string{"id", "created", "agree", "user", "text"}, rows
+}
+
+func hoursRows(v interface{}) ([]string, [][]string) {
+	hours := v.(*foursquarego.VenueHoursResp)
+	var rows [][]string
+	add := func(kind string, frames []foursquarego.HoursTimeFrame) {
+		for _, f := range frames {
+			days := make([]string, len(f.Days))
+			for i, d := range f.Days {
+				days[i] = strconv.Itoa(d)
+			}
+			open := make([]string, len(f.Open))
+			for i, o := range f.Open {
+				open[i] = o.Start + "-" + o.End
+			}
+			rows = append(rows, []string{kind, strings.Join(days, ","), strings.Join(open, ",")})
+		}
+	}
+	add("hours", hours.Hours.TimeFrames)
+	add("popular", hours.Popular.TimeFrames)
+	return []string{"type", "days", "open"}, rows
+}
+
+func menuRows(v interface{}) ([]string, [][]string) {
+	var rows [][]string
+	for _, menu := range v.(*foursquarego.MenuResp).Menus.Items {
+		for _, section := range menu.Entries.Items {
+			for _, entry := range section.Entries.Items {
+				price := entry.Price
+				if price == "" {
+					price = strings.Join(entry.Prices, ", ")
+				}
+				rows = append(rows, []string{menu.Name, section.Name, entry.EntryID, entry.Name, price, entry.Description})
+			}
+		}
+	}
+	return []string{"menu", "section", "id", "name", "price", "description"}, rows
+}
+
+func categoryRows(v interface{}) ([]string, [][]string) {
+	var rows [][]string
+	var walk func(categories []foursquarego.Category, parent string)
+	walk = func(categories []foursquarego.Category, parent string) {
+		for _, c := range categories {
+			path := c.Name
+			if parent != "" {
+				path = parent + " > " + c.Name
+			}
+			rows = append(rows, []string{c.ID, c.Name, path})
+			walk(c.Categories, path)
+		}
+	}
+	walk(v.([]foursquarego.Category), "")
+	return []string{"id", "name", "path"}, rows
+}
+
+func userName(u foursquarego.User) string {
+	return strings.TrimSpace(fmt.Sprintf("%s %s", u.FirstName, u.LastName))
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/peppage/foursquarego"
+)
+
+var boolAsAnIntType = reflect.TypeOf(foursquarego.BoolAsAnInt(0))
+
+// bindFlags adds a flag to fs for every field of the params struct, named
+// by the field's url tag. Fields tagged "-", the venue id in the path for
+// example, are left for the command to set.
+func bindFlags(fs *flag.FlagSet, params interface{}) {
+	v := reflect.ValueOf(params).Elem()
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name := strings.Split(field.Tag.Get("url"), ",")[0]
+		if name == "" || name == "-" {
+			continue
+		}
+
+		usage := field.Name
+		if field.Type.PkgPath() != "" && field.Type != boolAsAnIntType {
+			usage += " (" + field.Type.Name() + ")"
+		}
+		if field.Type.Kind() == reflect.Slice {
+			usage += ", comma separated"
+		}
+		fs.Var(&fieldValue{v.Field(i)}, name, usage)
+	}
+}
+
+// fieldValue is a flag.Value that sets a field of a params struct.
+type fieldValue struct {
+	v reflect.Value
+}
+
+func (f *fieldValue) String() string {
+	if !f.v.IsValid() || reflect.DeepEqual(f.v.Interface(), reflect.Zero(f.v.Type()).Interface()) {
+		return ""
+	}
+	if f.v.Kind() == reflect.Slice {
+		s := make([]string, f.v.Len())
+		for i := range s {
+			s[i] = fmt.Sprint(f.v.Index(i).Interface())
+		}
+		return strings.Join(s, ",")
+	}
+	return fmt.Sprint(f.v.Interface())
+}
+
+func (f *fieldValue) IsBoolFlag() bool {
+	return f.v.IsValid() && (f.v.Kind() == reflect.Bool || f.v.Type() == boolAsAnIntType)
+}
+
+func (f *fieldValue) Set(s string) error {
+	if f.v.Type() == boolAsAnIntType {
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		if b {
+			f.v.SetInt(1)
+		} else {
+			f.v.SetInt(0)
+		}
+		return nil
+	}
+
+	switch f.v.Kind() {
+	case reflect.String:
+		f.v.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		f.v.SetBool(b)
+	case reflect.Int, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return err
+		}
+		f.v.SetInt(n)
+	case reflect.Float64:
+		n, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return err
+		}
+		f.v.SetFloat(n)
+	case reflect.Slice:
+		for _, part := range strings.Split(s, ",") {
+			elem := reflect.New(f.v.Type().Elem()).Elem()
+			if err := (&fieldValue{elem}).Set(strings.TrimSpace(part)); err != nil {
+				return err
+			}
+			f.v.Set(reflect.Append(f.v, elem))
+		}
+	default:
+		return fmt.Errorf("unsupported flag type %s", f.v.Type())
+	}
+	return nil
+}
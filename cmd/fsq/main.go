@@ -0,0 +1,176 @@
+/*
+Command fsq queries the Foursquare API from the command line.
+
+	fsq <command> [flags] [args]
+
+Credentials are read from the environment. FOURSQUARE_CLIENT_ID is always
+needed along with FOURSQUARE_CLIENT_SECRET for userless requests or
+FOURSQUARE_ACCESS_TOKEN for requests acting as a user. FOURSQUARE_MODE sets
+the response mode, foursquare or swarm, and defaults to foursquare.
+
+The commands are
+
+	search      search for venues
+	explore     get recommended venues
+	suggest     complete a partial venue name
+	trending    get venues with the most people checked in
+	details     get a venue
+	photos      get the photos of a venue
+	tips        get the tips of a venue
+	hours       get the hours of a venue
+	menu        get the menu of a venue
+	categories  get the venue category tree
+	raw         GET any v2 path, venues/categories for example
+
+The flags of a command are named after the foursquare parameters, so
+search takes -ll, -query, -categoryId and so on. Lists like -categoryId
+are comma separated and flags like -openNow are booleans. Run a command
+with -h to see its flags.
+
+Every command takes -o to pick the output, json (the default), table or
+csv. raw only writes json. The rate limit left after a request is written
+to stderr.
+
+	fsq search -ll 40.7,-74 -query singlecut -o table
+	fsq explore -near Brooklyn -section drink -openNow -o csv
+	fsq tips -sort popular -limit 5 5414d0a6498ea3d31a3c64cf
+	fsq raw 'venues/5414d0a6498ea3d31a3c64cf/likes'
+*/
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/peppage/foursquarego"
+)
+
+const (
+	envClientID     = "FOURSQUARE_CLIENT_ID"
+	envClientSecret = "FOURSQUARE_CLIENT_SECRET"
+	envAccessToken  = "FOURSQUARE_ACCESS_TOKEN"
+	envMode         = "FOURSQUARE_MODE"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Getenv, http.DefaultClient, os.Stdout, os.Stderr))
+}
+
+// run runs the command in args and returns the exit code. Exit code 2 is
+// for usage errors and 1 for everything else.
+func run(args []string, getenv func(string) string, httpClient *http.Client, stdout, stderr io.Writer) int {
+	if len(args) == 0 || args[0] == "help" || args[0] == "-h" || args[0] == "--help" {
+		usage(stderr)
+		return 2
+	}
+
+	cmd := findCommand(args[0])
+	if cmd == nil {
+		fmt.Fprintf(stderr, "fsq: unknown command %q\n", args[0])
+		usage(stderr)
+		return 2
+	}
+
+	fs := flag.NewFlagSet("fsq "+cmd.name, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	format := fs.String("o", "json", "output format: json, table or csv")
+	var params interface{}
+	if cmd.params != nil {
+		params = cmd.params()
+		bindFlags(fs, params)
+	}
+	fs.Usage = func() {
+		fmt.Fprintf(stderr, "usage: fsq %s [flags] %s\n\n%s.\n\n", cmd.name, cmd.args, cmd.summary)
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args[1:]); err != nil {
+		return 2
+	}
+
+	if fs.NArg() != strings.Count(cmd.args, "<") {
+		fs.Usage()
+		return 2
+	}
+
+	out, err := newWriter(*format, stdout)
+	if err != nil {
+		fmt.Fprintf(stderr, "fsq: %v\n", err)
+		return 2
+	}
+	if cmd.rows == nil && *format != formatJSON {
+		fmt.Fprintf(stderr, "fsq: %s only writes json\n", cmd.name)
+		return 2
+	}
+
+	client, err := newClient(getenv, httpClient)
+	if err != nil {
+		fmt.Fprintf(stderr, "fsq: %v\n", err)
+		return 1
+	}
+
+	v, resp, err := cmd.call(client, params, fs.Args())
+	if resp != nil {
+		writeRate(stderr, resp)
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "fsq: %v\n", err)
+		return 1
+	}
+
+	if *format == formatJSON {
+		err = out.JSON(v)
+	} else {
+		header, rows := cmd.rows(v)
+		err = out.Rows(header, rows)
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "fsq: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+func newClient(getenv func(string) string, httpClient *http.Client) (*foursquarego.Client, error) {
+	clientID := getenv(envClientID)
+	clientSecret := getenv(envClientSecret)
+	accessToken := getenv(envAccessToken)
+	if clientID == "" {
+		return nil, fmt.Errorf("%s is not set", envClientID)
+	}
+	if clientSecret == "" && accessToken == "" {
+		return nil, fmt.Errorf("set %s or %s", envClientSecret, envAccessToken)
+	}
+
+	mode := getenv(envMode)
+	if mode == "" {
+		mode = "foursquare"
+	}
+	return foursquarego.NewClient(httpClient, mode, clientID, clientSecret, accessToken), nil
+}
+
+func writeRate(w io.Writer, resp *http.Response) {
+	rate := foursquarego.ParseRate(resp)
+	if rate.Limit == 0 {
+		return
+	}
+	fmt.Fprintf(w, "rate limit: %d of %d remaining", rate.Remaining, rate.Limit)
+	if rate.Path != "" {
+		fmt.Fprintf(w, " for %s", rate.Path)
+	}
+	fmt.Fprintln(w)
+}
+
+func usage(w io.Writer) {
+	fmt.Fprintln(w, "usage: fsq <command> [flags] [args]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "commands:")
+	for _, cmd := range commands {
+		fmt.Fprintf(w, "  %-11s %s\n", cmd.name, cmd.summary)
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintf(w, "credentials are read from %s and %s or %s\n", envClientID, envClientSecret, envAccessToken)
+}
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/peppage/foursquarego"
+	"github.com/peppage/foursquarego/foursquaretest"
+	"github.com/stretchr/testify/assert"
+)
+
+var testEnv = map[string]string{
+	envClientID:     "clientId",
+	envClientSecret: "clientSecret",
+}
+
+func runTest(server *foursquaretest.Server, env map[string]string, args ...string) (int, string, string) {
+	var stdout, stderr bytes.Buffer
+	getenv := func(key string) string { return env[key] }
+	code := run(args, getenv, server.Client(), &stdout, &stderr)
+	return code, stdout.String(), stderr.String()
+}
+
+func TestRun_SearchFlags(t *testing.T) {
+	server := foursquaretest.NewServer("../../json")
+	defer server.Close()
+
+	code, stdout, stderr := runTest(server, testEnv,
+		"search", "-ll", "40.7,-74", "-query", "singlecut", "-limit", "5", "-intent", "browse", "-categoryId", "a,b")
+	assert.Equal(t, 0, code)
+	assert.Equal(t, "rate limit: 4999 of 5000 remaining for /v2/venues/search\n", stderr)
+
+	var venues []foursquarego.Venue
+	assert.Nil(t, json.Unmarshal([]byte(stdout), &venues))
+	assert.Equal(t, "SingleCut Beersmiths", venues[0].Name)
+
+	query := server.Requests()[0].Query
+	assert.Equal(t, "/v2/venues/search", server.Requests()[0].Path)
+	assert.Equal(t, "40.7,-74", query.Get("ll"))
+	assert.Equal(t, "singlecut", query.Get("query"))
+	assert.Equal(t, "5", query.Get("limit"))
+	assert.Equal(t, "browse", query.Get("intent"))
+	assert.Equal(t, []string{"a", "b"}, query["categoryId"])
+	assert.Equal(t, "clientSecret", query.Get("client_secret"))
+}
+
+func TestRun_ExploreCSV(t *testing.T) {
+	server := foursquaretest.NewServer("../../json")
+	defer server.Close()
+
+	code, stdout, _ := runTest(server, testEnv, "explore", "-near", "Brooklyn", "-openNow", "-price", "1,2", "-o", "csv")
+	assert.Equal(t, 0, code)
+
+	query := server.Requests()[0].Query
+	assert.Equal(t, "1", query.Get("openNow"))
+	assert.Equal(t, "1,2", query.Get("price"))
+
+	records, err := csv.NewReader(strings.NewReader(stdout)).ReadAll()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"group", "id", "name", "category", "address", "lat", "lng", "distance"}, records[0])
+	assert.True(t, len(records) > 1)
+	assert.Equal(t, "recommended", records[1][0])
+}
+
+func TestRun_Table(t *testing.T) {
+	server := foursquaretest.NewServer("../../json")
+	defer server.Close()
+
+	code, stdout, _ := runTest(server, testEnv, "tips", "-sort", "popular", "-o", "table", "5414d0a6498ea3d31a3c64cf")
+	assert.Equal(t, 0, code)
+	assert.Equal(t, "/v2/venues/5414d0a6498ea3d31a3c64cf/tips", server.Requests()[0].Path)
+	assert.Equal(t, "popular", server.Requests()[0].Query.Get("sort"))
+
+	lines := strings.Split(strings.TrimSpace(stdout), "\n")
+	assert.True(t, strings.HasPrefix(lines[0], "ID"))
+	assert.Contains(t, lines[0], "TEXT")
+	assert.True(t, len(lines) > 1)
+
+	code, stdout, _ = runTest(server, testEnv, "categories", "-o", "csv")
+	assert.Equal(t, 0, code)
+	assert.Contains(t, stdout, "Arts & Entertainment > ")
+}
+
+func TestRun_Raw(t *testing.T) {
+	server := foursquaretest.NewServer("../../json")
+	defer server.Close()
+
+	code, stdout, _ := runTest(server, testEnv, "raw", "/v2/venues/5414d0a6498ea3d31a3c64cf/likes?limit=2")
+	assert.Equal(t, 0, code)
+	assert.Equal(t, "/v2/venues/5414d0a6498ea3d31a3c64cf/likes", server.Requests()[0].Path)
+	assert.Equal(t, "2", server.Requests()[0].Query.Get("limit"))
+
+	var likes map[string]json.RawMessage
+	assert.Nil(t, json.Unmarshal([]byte(stdout), &likes))
+	assert.Contains(t, likes, "likes")
+
+	code, _, stderr := runTest(server, testEnv, "raw", "-o", "table", "venues/categories")
+	assert.Equal(t, 2, code)
+	assert.Equal(t, "fsq: raw only writes json\n", stderr)
+}
+
+func TestRun_Errors(t *testing.T) {
+	server := foursquaretest.NewServer("../../json")
+	defer server.Close()
+
+	code, _, stderr := runTest(server, map[string]string{envClientID: "clientId"}, "details", "5414d0a6498ea3d31a3c64cf")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, envClientSecret)
+
+	code, _, _ = runTest(server, testEnv, "details")
+	assert.Equal(t, 2, code)
+
+	code, _, _ = runTest(server, testEnv, "nope")
+	assert.Equal(t, 2, code)
+
+	code, _, _ = runTest(server, testEnv, "search", "-o", "xml")
+	assert.Equal(t, 2, code)
+
+	server.HandleError("GET", "/v2/venues/*/menu", 404, "param_error", "Value nope is invalid for venue id")
+	code, _, stderr = runTest(server, testEnv, "menu", "nope")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "Value nope is invalid for venue id")
+}
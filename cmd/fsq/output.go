@@ -0,0 +1,58 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+)
+
+// Output formats for the -o flag.
+const (
+	formatJSON  = "json"
+	formatTable = "table"
+	formatCSV   = "csv"
+)
+
+type writer struct {
+	format string
+	w      io.Writer
+}
+
+func newWriter(format string, w io.Writer) (*writer, error) {
+	switch format {
+	case formatJSON, formatTable, formatCSV:
+		return &writer{format: format, w: w}, nil
+	}
+	return nil, fmt.Errorf("unknown output format %q, use json, table or csv", format)
+}
+
+// JSON writes v indented.
+func (w *writer) JSON(v interface{}) error {
+	enc := json.NewEncoder(w.w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+// Rows writes a header and rows as a table or csv.
+func (w *writer) Rows(header []string, rows [][]string) error {
+	if w.format == formatCSV {
+		cw := csv.NewWriter(w.w)
+		cw.Write(header)
+		cw.WriteAll(rows)
+		return cw.Error()
+	}
+
+	tw := tabwriter.NewWriter(w.w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, strings.ToUpper(strings.Join(header, "\t")))
+	for _, row := range rows {
+		cells := make([]string, len(row))
+		for i, cell := range row {
+			cells[i] = strings.NewReplacer("\t", " ", "\n", " ").Replace(cell)
+		}
+		fmt.Fprintln(tw, strings.Join(cells, "\t"))
+	}
+	return tw.Flush()
+}
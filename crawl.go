@@ -0,0 +1,296 @@
+package foursquarego
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ErrRateLimitReached is returned by a Crawler when foursquare reports that
+// no requests are remaining. The returned CrawlCheckpoint can be resumed
+// once the limit resets.
+var ErrRateLimitReached = errors.New("foursquare: rate limit reached")
+
+var errNilCheckpoint = errors.New("foursquarego: resume needs a crawl checkpoint")
+
+// CrawlCheckpoint is the state of a crawl. It can be saved as json and
+// passed to Crawler.Resume to carry on where a crawl stopped.
+type CrawlCheckpoint struct {
+	Pending []SuggestedBounds `json:"pending"`
+	Seen    []string          `json:"seen"`
+	// Truncated are cells no bigger than the MinCellSize that still hit
+	// the result cap, so some of their venues may be missing.
+	Truncated []SuggestedBounds `json:"truncated,omitempty"`
+}
+
+// Done is true when there are no cells left to crawl.
+func (c *CrawlCheckpoint) Done() bool {
+	return len(c.Pending) == 0
+}
+
+// crawlFetch returns the venues in a cell and whether the endpoint's
+// result cap was hit, meaning the cell may have more venues.
+type crawlFetch func(cell SuggestedBounds) ([]Venue, bool, *http.Response, error)
+
+// Crawler covers a bounding box by splitting it into cells. Any cell that
+// hits the result cap of the endpoint is split into quarters and crawled
+// again. Venues are deduplicated by ID.
+type Crawler struct {
+	fetch crawlFetch
+
+	// Concurrency is the number of cells fetched at once.
+	Concurrency int
+	// Interval is the least time between two requests.
+	Interval time.Duration
+	// MinCellSize in degrees. Cells smaller than this are not split even
+	// if they hit the result cap.
+	MinCellSize float64
+
+	mu   sync.Mutex
+	next time.Time
+}
+
+// NewSearchCrawler returns a Crawler over VenueService.Search. The Sw, Ne,
+// Intent and Limit of params are set for each cell.
+func NewSearchCrawler(s *VenueService, params VenueSearchParams) *Crawler {
+	const searchCap = 50
+	return newCrawler(func(cell SuggestedBounds) ([]Venue, bool, *http.Response, error) {
+		p := params
+		p.LatLong = ""
+		p.Near = ""
+		p.Radius = 0
+		p.Intent = IntentBrowse
+		p.Limit = searchCap
+		p.Sw = cell.Sw.String()
+		p.Ne = cell.Ne.String()
+
+		venues, resp, err := s.Search(&p)
+		return venues, len(venues) >= searchCap, resp, err
+	})
+}
+
+// NewExploreCrawler returns a Crawler over VenueService.Explore. Explore
+// has no bounds parameter so each cell is explored from its center with a
+// radius reaching its corners, and venues outside the cell are dropped.
+// The LatLong, Radius, Limit and Offset of params are set for each cell.
+func NewExploreCrawler(s *VenueService, params VenueExploreParams) *Crawler {
+	const (
+		exploreCap  = 100
+		explorePage = 50
+	)
+	return newCrawler(func(cell SuggestedBounds) ([]Venue, bool, *http.Response, error) {
+		center := cell.Center()
+
+		p := params
+		p.Near = ""
+		p.LatLong = center.String()
+		p.Radius = int(math.Ceil(Distance(center, cell.Ne)))
+		p.Limit = explorePage
+
+		var venues []Venue
+		var resp *http.Response
+		total := 0
+		for p.Offset = 0; p.Offset < exploreCap; p.Offset += explorePage {
+			explore, r, err := s.Explore(&p)
+			resp = r
+			if err != nil {
+				return nil, false, resp, err
+			}
+
+			n := 0
+			for _, g := range explore.Groups {
+				for _, item := range g.Items {
+					n++
+					if cell.Contains(item.Venue) {
+						venues = append(venues, item.Venue)
+					}
+				}
+			}
+			total += n
+			if n < explorePage {
+				break
+			}
+		}
+		return venues, total >= exploreCap, resp, nil
+	})
+}
+
+func newCrawler(fetch crawlFetch) *Crawler {
+	return &Crawler{
+		fetch:       fetch,
+		Concurrency: 1,
+		MinCellSize: 0.0005,
+	}
+}
+
+// Crawl sends every venue in bounds to out, closing it when done. The
+// returned CrawlCheckpoint can be used with Resume if an error stopped
+// the crawl, and lists the cells that were Truncated.
+func (c *Crawler) Crawl(ctx context.Context, bounds SuggestedBounds, out chan<- Venue) (*CrawlCheckpoint, error) {
+	return c.Resume(ctx, &CrawlCheckpoint{Pending: []SuggestedBounds{bounds}}, out)
+}
+
+// Resume carries on a crawl from a CrawlCheckpoint, sending venues not yet
+// seen to out and closing it when done.
+func (c *Crawler) Resume(ctx context.Context, checkpoint *CrawlCheckpoint, out chan<- Venue) (*CrawlCheckpoint, error) {
+	defer close(out)
+	if checkpoint == nil {
+		return nil, errNilCheckpoint
+	}
+
+	pending := append([]SuggestedBounds(nil), checkpoint.Pending...)
+	truncated := append([]SuggestedBounds(nil), checkpoint.Truncated...)
+	seen := make(map[string]bool, len(checkpoint.Seen))
+	for _, id := range checkpoint.Seen {
+		seen[id] = true
+	}
+
+	var (
+		mu       sync.Mutex
+		cond     = sync.NewCond(&mu)
+		active   int
+		crawlErr error
+		wg       sync.WaitGroup
+	)
+
+	workers := c.Concurrency
+	if workers < 1 {
+		workers = 1
+	}
+
+	worker := func() {
+		defer wg.Done()
+		for {
+			mu.Lock()
+			for len(pending) == 0 && active > 0 && crawlErr == nil {
+				cond.Wait()
+			}
+			if len(pending) == 0 || crawlErr != nil {
+				mu.Unlock()
+				cond.Broadcast()
+				return
+			}
+			cell := pending[len(pending)-1]
+			pending = pending[:len(pending)-1]
+			active++
+			mu.Unlock()
+
+			venues, full, resp, err := c.crawlCell(ctx, cell)
+
+			mu.Lock()
+			if err != nil {
+				pending = append(pending, cell)
+				if crawlErr == nil {
+					crawlErr = err
+				}
+				active--
+				mu.Unlock()
+				cond.Broadcast()
+				return
+			}
+
+			var unseen []Venue
+			for _, v := range venues {
+				if !seen[v.ID] {
+					seen[v.ID] = true
+					unseen = append(unseen, v)
+				}
+			}
+			split := full && cell.Ne.Lat-cell.Sw.Lat > c.MinCellSize && cell.lngSpan() > c.MinCellSize
+			if resp != nil {
+				if rl := ParseRate(resp); rl.Limit > 0 && rl.Remaining <= 0 && crawlErr == nil {
+					crawlErr = ErrRateLimitReached
+				}
+			}
+			mu.Unlock()
+
+			sent := true
+		send:
+			for i, v := range unseen {
+				select {
+				case out <- v:
+				case <-ctx.Done():
+					// Crawl the cell again on resume to send what is left.
+					mu.Lock()
+					for _, u := range unseen[i:] {
+						delete(seen, u.ID)
+					}
+					pending = append(pending, cell)
+					if crawlErr == nil {
+						crawlErr = ctx.Err()
+					}
+					mu.Unlock()
+					sent = false
+					break send
+				}
+			}
+
+			// The quarters are only crawled once the cell is done, otherwise
+			// a resume would crawl the cell and its quarters.
+			mu.Lock()
+			switch {
+			case !sent:
+			case split:
+				pending = append(pending, splitCell(cell)...)
+			case full:
+				truncated = append(truncated, cell)
+			}
+			active--
+			mu.Unlock()
+			cond.Broadcast()
+		}
+	}
+
+	wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go worker()
+	}
+	wg.Wait()
+
+	cp := &CrawlCheckpoint{Pending: pending, Truncated: truncated}
+	for id := range seen {
+		cp.Seen = append(cp.Seen, id)
+	}
+	return cp, crawlErr
+}
+
+// crawlCell waits for the Interval since the last request then fetches
+// the cell.
+func (c *Crawler) crawlCell(ctx context.Context, cell SuggestedBounds) ([]Venue, bool, *http.Response, error) {
+	c.mu.Lock()
+	now := time.Now()
+	wait := c.next.Sub(now)
+	if wait < 0 {
+		wait = 0
+	}
+	c.next = now.Add(wait + c.Interval)
+	c.mu.Unlock()
+
+	if wait > 0 {
+		t := time.NewTimer(wait)
+		defer t.Stop()
+		select {
+		case <-t.C:
+		case <-ctx.Done():
+			return nil, false, nil, ctx.Err()
+		}
+	} else if err := ctx.Err(); err != nil {
+		return nil, false, nil, err
+	}
+
+	return c.fetch(cell)
+}
+
+// splitCell returns the four quarters of a cell.
+func splitCell(cell SuggestedBounds) []SuggestedBounds {
+	mid := cell.Center()
+	return []SuggestedBounds{
+		{Sw: cell.Sw, Ne: mid},
+		{Sw: LatLong{Lat: cell.Sw.Lat, Lng: mid.Lng}, Ne: LatLong{Lat: mid.Lat, Lng: cell.Ne.Lng}},
+		{Sw: LatLong{Lat: mid.Lat, Lng: cell.Sw.Lng}, Ne: LatLong{Lat: cell.Ne.Lat, Lng: mid.Lng}},
+		{Sw: mid, Ne: cell.Ne},
+	}
+}
@@ -0,0 +1,259 @@
+package foursquarego
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// gridVenues are 120 venues spread over 40.00,-74.00 to 40.11,-73.91.
+func gridVenues() []map[string]interface{} {
+	var venues []map[string]interface{}
+	for i := 0; i < 12; i++ {
+		for j := 0; j < 10; j++ {
+			venues = append(venues, map[string]interface{}{
+				"id":       fmt.Sprintf("v%d-%d", i, j),
+				"name":     fmt.Sprintf("Venue %d %d", i, j),
+				"location": map[string]float64{"lat": 40.005 + float64(i)*0.01, "lng": -73.995 + float64(j)*0.01},
+			})
+		}
+	}
+	return venues
+}
+
+func parseLatLong(s string) (float64, float64) {
+	parts := strings.Split(s, ",")
+	lat, _ := strconv.ParseFloat(parts[0], 64)
+	lng, _ := strconv.ParseFloat(parts[1], 64)
+	return lat, lng
+}
+
+func crawlHandler(t *testing.T, fail func(n int) bool) http.HandlerFunc {
+	return crawlHandlerFor(t, gridVenues(), fail)
+}
+
+// crawlHandlerFor answers searches with up to 50 of the venues inside the
+// sw and ne bounds.
+func crawlHandlerFor(t *testing.T, all []map[string]interface{}, fail func(n int) bool) http.HandlerFunc {
+	var mu sync.Mutex
+	requests := 0
+	return func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		mu.Lock()
+		requests++
+		n := requests
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		if fail(n) {
+			w.WriteHeader(http.StatusTooManyRequests)
+			w.Write([]byte(`{"meta":{"code":429,"errorType":"rate_limit_exceeded","errorDetail":"Quota exceeded"},"response":{}}`))
+			return
+		}
+
+		q := r.URL.Query()
+		assert.Equal(t, "browse", q.Get("intent"))
+		assert.Equal(t, "50", q.Get("limit"))
+		swLat, swLng := parseLatLong(q.Get("sw"))
+		neLat, neLng := parseLatLong(q.Get("ne"))
+		cell := SuggestedBounds{Sw: LatLong{Lat: swLat, Lng: swLng}, Ne: LatLong{Lat: neLat, Lng: neLng}}
+
+		var venues []map[string]interface{}
+		for _, v := range all {
+			loc := v["location"].(map[string]float64)
+			if cell.Contains(LatLong{Lat: loc["lat"], Lng: loc["lng"]}) && len(venues) < 50 {
+				venues = append(venues, v)
+			}
+		}
+
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"meta":     map[string]int{"code": 200},
+			"response": map[string]interface{}{"venues": venues},
+		})
+	}
+}
+
+var crawlBounds = SuggestedBounds{
+	Sw: LatLong{Lat: 40.0, Lng: -74.0},
+	Ne: LatLong{Lat: 40.12, Lng: -73.9},
+}
+
+func TestCrawler_Crawl(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/v2/venues/search", crawlHandler(t, func(int) bool { return false }))
+
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	crawler := NewSearchCrawler(client.Venues, VenueSearchParams{CategoryID: []string{"4d4b7105d754a06374d81259"}})
+	crawler.Concurrency = 4
+
+	out := make(chan Venue)
+	ids := make(map[string]int)
+	done := make(chan struct{})
+	go func() {
+		for v := range out {
+			ids[v.ID]++
+		}
+		close(done)
+	}()
+
+	cp, err := crawler.Crawl(context.Background(), crawlBounds, out)
+	<-done
+	assert.Nil(t, err)
+	assert.True(t, cp.Done())
+	assert.Len(t, cp.Seen, 120)
+	assert.Len(t, ids, 120)
+	for id, n := range ids {
+		assert.Equal(t, 1, n, id)
+	}
+}
+
+func TestCrawler_Resume(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	failing := true
+	mux.HandleFunc("/v2/venues/search", crawlHandler(t, func(n int) bool { return failing && n == 3 }))
+
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	crawler := NewSearchCrawler(client.Venues, VenueSearchParams{})
+
+	ids := make(map[string]int)
+	collect := func() (chan Venue, chan struct{}) {
+		out := make(chan Venue)
+		done := make(chan struct{})
+		go func() {
+			for v := range out {
+				ids[v.ID]++
+			}
+			close(done)
+		}()
+		return out, done
+	}
+
+	out, done := collect()
+	cp, err := crawler.Crawl(context.Background(), crawlBounds, out)
+	<-done
+	assert.IsType(t, &APIError{}, err)
+	assert.False(t, cp.Done())
+
+	b, err := json.Marshal(cp)
+	assert.Nil(t, err)
+	saved := new(CrawlCheckpoint)
+	assert.Nil(t, json.Unmarshal(b, saved))
+
+	failing = false
+	out, done = collect()
+	cp, err = crawler.Resume(context.Background(), saved, out)
+	<-done
+	assert.Nil(t, err)
+	assert.True(t, cp.Done())
+	assert.Len(t, ids, 120)
+	for id, n := range ids {
+		assert.Equal(t, 1, n, id)
+	}
+}
+
+func TestCrawler_RateLimitReached(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	handler := crawlHandler(t, func(int) bool { return false })
+	mux.HandleFunc("/v2/venues/search", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerRateLimit, "500")
+		w.Header().Set(headerRateRemaining, "0")
+		handler(w, r)
+	})
+
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	crawler := NewSearchCrawler(client.Venues, VenueSearchParams{})
+
+	out := make(chan Venue, 200)
+	cp, err := crawler.Crawl(context.Background(), crawlBounds, out)
+	assert.Equal(t, ErrRateLimitReached, err)
+	assert.False(t, cp.Done())
+	assert.Len(t, cp.Seen, 50)
+}
+
+func TestCrawler_CrawlAntimeridian(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	// 120 venues from 179.945 east across the antimeridian to -179.945.
+	var all []map[string]interface{}
+	for i := 0; i < 12; i++ {
+		for j := 0; j < 10; j++ {
+			all = append(all, map[string]interface{}{
+				"id":       fmt.Sprintf("v%d-%d", i, j),
+				"location": map[string]float64{"lat": 40.005 + float64(i)*0.01, "lng": wrapLng(179.955 + float64(j)*0.01)},
+			})
+		}
+	}
+	mux.HandleFunc("/v2/venues/search", crawlHandlerFor(t, all, func(int) bool { return false }))
+
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	crawler := NewSearchCrawler(client.Venues, VenueSearchParams{})
+
+	out := make(chan Venue, 200)
+	cp, err := crawler.Crawl(context.Background(), SuggestedBounds{
+		Sw: LatLong{Lat: 40.0, Lng: 179.95},
+		Ne: LatLong{Lat: 40.12, Lng: -179.95},
+	}, out)
+	assert.Nil(t, err)
+	assert.True(t, cp.Done())
+	assert.Empty(t, cp.Truncated)
+	assert.Len(t, cp.Seen, 120)
+}
+
+func TestCrawler_CrawlTruncated(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/v2/venues/search", crawlHandler(t, func(int) bool { return false }))
+
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	crawler := NewSearchCrawler(client.Venues, VenueSearchParams{})
+	crawler.MinCellSize = 1
+
+	out := make(chan Venue, 200)
+	cp, err := crawler.Crawl(context.Background(), crawlBounds, out)
+	assert.Nil(t, err)
+	assert.True(t, cp.Done())
+	assert.Equal(t, []SuggestedBounds{crawlBounds}, cp.Truncated)
+	assert.Len(t, cp.Seen, 50)
+}
+
+func TestCrawler_CancelWhileSending(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/v2/venues/search", crawlHandler(t, func(int) bool { return false }))
+
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	crawler := NewSearchCrawler(client.Venues, VenueSearchParams{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	out := make(chan Venue)
+	go func() {
+		for i := 0; i < 10; i++ {
+			<-out
+		}
+		cancel()
+	}()
+
+	cp, err := crawler.Crawl(ctx, crawlBounds, out)
+	assert.Equal(t, context.Canceled, err)
+	assert.Equal(t, []SuggestedBounds{crawlBounds}, cp.Pending)
+	assert.Len(t, cp.Seen, 10)
+
+	_, err = crawler.Resume(context.Background(), nil, make(chan Venue))
+	assert.NotNil(t, err)
+}
@@ -26,5 +26,16 @@ For Authentication the just send either the Client Secret or the users's Access
 to the client it will send both to foursquare. Foursquare expects that if you're making a request
 for a user you will send the Access Token. More information can be found on their auth page, https://developer.foursquare.com/docs/api/configuration/authentication
 
+The Places API v3 has its own service which authenticates with an API key.
+
+    places := foursquarego.NewPlaceService(httpClient, "apiKey")
+    results, resp, err := places.Search(&PlaceSearchParams{
+        LatLong: "40.7,-74",
+        Query:   "singlecut",
+    })
+
+    // The next page of results
+    cursor := foursquarego.NextCursor(resp)
+
 */
 package foursquarego
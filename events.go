@@ -0,0 +1,107 @@
+package foursquarego
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/dghubble/sling"
+)
+
+// EventService provides a method for accessing Foursquare event endpoints
+type EventService struct {
+	sling *sling.Sling
+}
+
+func newEventService(sling *sling.Sling) *EventService {
+	return &EventService{
+		sling: sling.Path("events/"),
+	}
+}
+
+type eventResp struct {
+	Event Event `json:"event"`
+}
+
+// Details gets all the data for an event
+// https://developer.foursquare.com/docs/api/events/details
+func (s *EventService) Details(id string) (*Event, *http.Response, error) {
+	event := new(eventResp)
+	response := new(Response)
+
+	resp, err := s.sling.New().Get(id).Receive(response, response)
+	if err == nil {
+		json.Unmarshal(response.Response, event)
+	}
+
+	return &event.Event, resp, relevantError(err, *response)
+}
+
+type eventCategoriesResp struct {
+	Categories []Category `json:"categories"`
+}
+
+// Categories returns a hierarchical list of categories applied to events.
+// https://developer.foursquare.com/docs/api/events/categories
+func (s *EventService) Categories() ([]Category, *http.Response, error) {
+	cats := new(eventCategoriesResp)
+	response := new(Response)
+
+	resp, err := s.sling.New().Get("categories").Receive(response, response)
+	if err == nil {
+		json.Unmarshal(response.Response, cats)
+	}
+
+	return cats.Categories, resp, relevantError(err, *response)
+}
+
+// EventSearchParams are the parameters for EventService.Search. Domain is
+// the provider, songkick.com for example, and EventID or ParticipantID
+// is the identifier the provider uses.
+type EventSearchParams struct {
+	Domain        string `url:"domain"`
+	EventID       string `url:"eventId,omitempty"`
+	ParticipantID string `url:"participantId,omitempty"`
+}
+
+type eventSearchResp struct {
+	Events Events `json:"events"`
+}
+
+// Search finds events by the identifier a provider uses for them.
+// https://developer.foursquare.com/docs/api/events/search
+func (s *EventService) Search(params *EventSearchParams) (*Events, *http.Response, error) {
+	events := new(eventSearchResp)
+	response := new(Response)
+
+	resp, err := s.sling.New().Get("search").QueryStruct(params).Receive(response, response)
+	if err == nil {
+		json.Unmarshal(response.Response, events)
+	}
+
+	return &events.Events, resp, relevantError(err, *response)
+}
+
+// EventAddParams are the parameters for EventService.Add
+type EventAddParams struct {
+	VenueID        string    `url:"venueId"`
+	Name           string    `url:"name"`
+	Start          time.Time `url:"start,unix"`
+	End            time.Time `url:"end,unix"`
+	CategoryID     string    `url:"categoryId,omitempty"`
+	ParticipantIDs []string  `url:"participantIds,comma,omitempty"`
+}
+
+// Add creates an event at a venue.
+// https://developer.foursquare.com/docs/api/events/add
+func (s *EventService) Add(params *EventAddParams) (*Event, *http.Response, error) {
+	event := new(eventResp)
+	response := new(Response)
+
+	resp, err := s.sling.New().Post("add").BodyForm(params).Receive(response, response)
+	if err == nil {
+		json.Unmarshal(response.Response, event)
+	}
+
+	return &event.Event, resp, relevantError(err, *response)
+}
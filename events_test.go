@@ -0,0 +1,153 @@
+package foursquarego
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventService_Details(t *testing.T) {
+	const filePath = "./json/events/details.json"
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/v2/events/5b0e1f2c1f6e8a002c7d4e8a", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQueryNoUser(t, map[string]string{}, r)
+
+		b, err := getTestFile(filePath)
+		if err != nil {
+			t.Fatalf("Failed to open testfile %s", filePath)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+	})
+
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	event, _, err := client.Events.Details("5b0e1f2c1f6e8a002c7d4e8a")
+	assert.Nil(t, err)
+
+	assert.Equal(t, "5b0e1f2c1f6e8a002c7d4e8a", event.ID)
+	assert.Equal(t, "Parquet Courts", event.Name)
+	assert.Equal(t, "Music Event", event.Categories[0].Name)
+	assert.Equal(t, false, event.AllDay)
+	assert.Equal(t, "4a69f02ef964a52053cc1fe3", event.Venue.ID)
+	assert.Equal(t, "Music Hall of Williamsburg", event.Venue.Name)
+
+	start, err := event.LocalStartAt()
+	assert.Nil(t, err)
+	assert.Equal(t, "2018-06-20T20:00:00-04:00", start.Format(time.RFC3339))
+	end, err := event.LocalEndAt()
+	assert.Nil(t, err)
+	assert.Equal(t, 3*time.Hour, end.Sub(start))
+}
+
+func TestEventService_Categories(t *testing.T) {
+	const filePath = "./json/events/categories.json"
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/v2/events/categories", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQueryNoUser(t, map[string]string{}, r)
+
+		b, err := getTestFile(filePath)
+		if err != nil {
+			t.Fatalf("Failed to open testfile %s", filePath)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+	})
+
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	categories, _, err := client.Events.Categories()
+	assert.Nil(t, err)
+
+	assert.Len(t, categories, 2)
+	assert.Equal(t, "4dfb90c6bd413dd705e8f897", categories[0].ID)
+	assert.Equal(t, "Music Event", categories[0].Name)
+	assert.Equal(t, "Music Events", categories[0].PluralName)
+	assert.Len(t, categories[0].Categories, 2)
+	assert.Equal(t, "Concert", categories[0].Categories[0].Name)
+	assert.Equal(t, ".png", categories[0].Categories[0].Icon.Suffix)
+}
+
+func TestEventService_Search(t *testing.T) {
+	const filePath = "./json/events/search.json"
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/v2/events/search", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQueryNoUser(t, map[string]string{
+			"domain":  "songkick.com",
+			"eventId": "33401234",
+		}, r)
+
+		b, err := getTestFile(filePath)
+		if err != nil {
+			t.Fatalf("Failed to open testfile %s", filePath)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+	})
+
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	events, _, err := client.Events.Search(&EventSearchParams{
+		Domain:  "songkick.com",
+		EventID: "33401234",
+	})
+	assert.Nil(t, err)
+
+	assert.Equal(t, 1, events.Count)
+	assert.Equal(t, "5b0e1f2c1f6e8a002c7d4e8a", events.Items[0].ID)
+}
+
+func TestEventService_Add(t *testing.T) {
+	const filePath = "./json/events/details.json"
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/v2/events/add", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		assertQueryNoUser(t, map[string]string{}, r)
+		assertForm(t, map[string]string{
+			"venueId":        "4a69f02ef964a52053cc1fe3",
+			"name":           "Parquet Courts",
+			"start":          "1529539200",
+			"end":            "1529550000",
+			"categoryId":     "5267e4d9e4b0ec79466e48c6",
+			"participantIds": "4e2f1b1c1495c8d4a1b5c1b2,4e2f1b1c1495c8d4a1b5c1b3",
+		}, r)
+
+		b, err := getTestFile(filePath)
+		if err != nil {
+			t.Fatalf("Failed to open testfile %s", filePath)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+	})
+
+	start := time.Unix(1529539200, 0)
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	event, _, err := client.Events.Add(&EventAddParams{
+		VenueID:    "4a69f02ef964a52053cc1fe3",
+		Name:       "Parquet Courts",
+		Start:      start,
+		End:        start.Add(3 * time.Hour),
+		CategoryID: "5267e4d9e4b0ec79466e48c6",
+		ParticipantIDs: []string{
+			"4e2f1b1c1495c8d4a1b5c1b2",
+			"4e2f1b1c1495c8d4a1b5c1b3",
+		},
+	})
+	assert.Nil(t, err)
+
+	assert.Equal(t, "5b0e1f2c1f6e8a002c7d4e8a", event.ID)
+}
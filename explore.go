@@ -0,0 +1,178 @@
+package foursquarego
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Keys of the SuggestedFilters that ApplyFilter understands.
+const (
+	FilterOpenNow        = "openNow"
+	FilterPrice          = "price"
+	FilterSpecials       = "specials"
+	FilterNovelty        = "novelty"
+	FilterFriendVisits   = "friendVisits"
+	FilterSaved          = "saved"
+	FilterSortByDistance = "sortByDistance"
+)
+
+// ApplyFilter sets the parameters for one of the SuggestedFilters of an
+// Explore response. The price filter reads its tiers from the Name, "$$"
+// or "$-$$$" for example, and is every tier when the name has none. The
+// novelty and friendVisits filters pick new and visited unless the Name
+// says otherwise.
+func (p *VenueExploreParams) ApplyFilter(f Filter) error {
+	switch f.Key {
+	case FilterOpenNow:
+		p.OpenNow = 1
+	case FilterPrice:
+		p.Price = parsePriceFilter(f.Name)
+	case FilterSpecials:
+		p.Specials = 1
+	case FilterNovelty:
+		p.Novelty = NoveltyNew
+		if strings.Contains(strings.ToLower(f.Name), "been") && !negated(f.Name) {
+			p.Novelty = NoveltyOld
+		}
+	case FilterFriendVisits:
+		p.FriendVisits = FriendVisited
+		if negated(f.Name) {
+			p.FriendVisits = FriendNotVisited
+		}
+	case FilterSaved:
+		p.Saved = 1
+	case FilterSortByDistance:
+		p.SortByDistance = 1
+	default:
+		return fmt.Errorf("foursquarego: unknown explore filter %q", f.Key)
+	}
+	return nil
+}
+
+// RemoveFilter clears the parameters set by ApplyFilter for key.
+func (p *VenueExploreParams) RemoveFilter(key string) {
+	switch key {
+	case FilterOpenNow:
+		p.OpenNow = 0
+	case FilterPrice:
+		p.Price = nil
+	case FilterSpecials:
+		p.Specials = 0
+	case FilterNovelty:
+		p.Novelty = ""
+	case FilterFriendVisits:
+		p.FriendVisits = ""
+	case FilterSaved:
+		p.Saved = 0
+	case FilterSortByDistance:
+		p.SortByDistance = 0
+	}
+}
+
+// ActiveFilters returns a Filter for each filter set on params, the
+// reverse of ApplyFilter. Applying them to new params gives the same
+// filters back.
+func ActiveFilters(params *VenueExploreParams) []Filter {
+	var filters []Filter
+	if params.OpenNow != 0 {
+		filters = append(filters, Filter{Name: "Open now", Key: FilterOpenNow})
+	}
+	if len(params.Price) > 0 {
+		filters = append(filters, Filter{Name: formatPriceFilter(params.Price), Key: FilterPrice})
+	}
+	if params.Specials != 0 {
+		filters = append(filters, Filter{Name: "Specials", Key: FilterSpecials})
+	}
+	switch params.Novelty {
+	case NoveltyNew:
+		filters = append(filters, Filter{Name: "I haven't been", Key: FilterNovelty})
+	case NoveltyOld:
+		filters = append(filters, Filter{Name: "I've been", Key: FilterNovelty})
+	}
+	switch params.FriendVisits {
+	case FriendVisited:
+		filters = append(filters, Filter{Name: "Friends have been", Key: FilterFriendVisits})
+	case FriendNotVisited:
+		filters = append(filters, Filter{Name: "Friends haven't been", Key: FilterFriendVisits})
+	}
+	if params.Saved != 0 {
+		filters = append(filters, Filter{Name: "Saved", Key: FilterSaved})
+	}
+	if params.SortByDistance != 0 {
+		filters = append(filters, Filter{Name: "Sort by distance", Key: FilterSortByDistance})
+	}
+	return filters
+}
+
+// ApplySuggestions narrows params to the area of an Explore response, the
+// SuggestedRadius around the center of the SuggestedBounds, so a refined
+// search covers the same venues. Near is cleared when the bounds are used.
+func (p *VenueExploreParams) ApplySuggestions(r *VenueExploreResp) {
+	if r.SuggestedBounds != (SuggestedBounds{}) {
+		p.LatLong = r.SuggestedBounds.Center().String()
+		p.Near = ""
+	}
+	if r.SuggestedRadius > 0 {
+		p.Radius = r.SuggestedRadius
+	}
+}
+
+// parsePriceFilter reads tiers from "$$", "$-$$$" or "$,$$$".
+func parsePriceFilter(name string) []int {
+	var tiers []int
+	for _, part := range strings.Split(name, ",") {
+		bounds := strings.SplitN(part, "-", 2)
+		low := priceTier(bounds[0])
+		high := low
+		if len(bounds) == 2 {
+			high = priceTier(bounds[1])
+		}
+		if low == 0 || high < low {
+			continue
+		}
+		for tier := low; tier <= high; tier++ {
+			tiers = append(tiers, tier)
+		}
+	}
+	if len(tiers) == 0 {
+		return []int{1, 2, 3, 4}
+	}
+	sort.Ints(tiers)
+	return tiers
+}
+
+func priceTier(s string) int {
+	tier := strings.Count(s, "$")
+	if tier > 4 {
+		return 0
+	}
+	return tier
+}
+
+// formatPriceFilter writes tiers the way parsePriceFilter reads them,
+// with runs as ranges.
+func formatPriceFilter(tiers []int) string {
+	tiers = append([]int(nil), tiers...)
+	sort.Ints(tiers)
+
+	var parts []string
+	for i := 0; i < len(tiers); {
+		j := i
+		for j+1 < len(tiers) && tiers[j+1] <= tiers[j]+1 {
+			j++
+		}
+		part := strings.Repeat("$", tiers[i])
+		if tiers[j] != tiers[i] {
+			part += "-" + strings.Repeat("$", tiers[j])
+		}
+		parts = append(parts, part)
+		i = j + 1
+	}
+	return strings.Join(parts, ",")
+}
+
+func negated(name string) bool {
+	name = strings.ToLower(name)
+	return strings.Contains(name, "n't") || strings.Contains(name, "not")
+}
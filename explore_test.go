@@ -0,0 +1,112 @@
+package foursquarego
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVenueExploreParams_ApplyFilter(t *testing.T) {
+	explore := testExploreResp(t)
+
+	params := &VenueExploreParams{Near: "Chicago, IL"}
+	for _, f := range explore.SuggestedFilters.Filters {
+		assert.Nil(t, params.ApplyFilter(f))
+	}
+	assert.Equal(t, []int{1, 2, 3, 4}, params.Price)
+	assert.Equal(t, NoveltyNew, params.Novelty)
+
+	assert.Nil(t, params.ApplyFilter(Filter{Name: "Open now", Key: "openNow"}))
+	assert.Nil(t, params.ApplyFilter(Filter{Name: "$$", Key: "price"}))
+	assert.Nil(t, params.ApplyFilter(Filter{Name: "Friends haven't been", Key: "friendVisits"}))
+	assert.Equal(t, BoolAsAnInt(1), params.OpenNow)
+	assert.Equal(t, []int{2}, params.Price)
+	assert.Equal(t, FriendNotVisited, params.FriendVisits)
+
+	assert.NotNil(t, params.ApplyFilter(Filter{Name: "Dogs allowed", Key: "dogs"}))
+
+	params.RemoveFilter(FilterPrice)
+	params.RemoveFilter(FilterOpenNow)
+	assert.Nil(t, params.Price)
+	assert.Equal(t, BoolAsAnInt(0), params.OpenNow)
+}
+
+func TestActiveFilters(t *testing.T) {
+	params := &VenueExploreParams{
+		Query:          "beer",
+		OpenNow:        1,
+		Price:          []int{4, 1, 2},
+		Specials:       1,
+		Novelty:        NoveltyOld,
+		FriendVisits:   FriendVisited,
+		Saved:          1,
+		SortByDistance: 1,
+	}
+
+	filters := ActiveFilters(params)
+	assert.Equal(t, []Filter{
+		{Name: "Open now", Key: "openNow"},
+		{Name: "$-$$,$$$$", Key: "price"},
+		{Name: "Specials", Key: "specials"},
+		{Name: "I've been", Key: "novelty"},
+		{Name: "Friends have been", Key: "friendVisits"},
+		{Name: "Saved", Key: "saved"},
+		{Name: "Sort by distance", Key: "sortByDistance"},
+	}, filters)
+
+	again := &VenueExploreParams{Query: "beer"}
+	for _, f := range filters {
+		assert.Nil(t, again.ApplyFilter(f))
+	}
+	params.Price = []int{1, 2, 4}
+	assert.Equal(t, params, again)
+
+	assert.Equal(t, []Filter{{Name: "I haven't been", Key: "novelty"}}, ActiveFilters(&VenueExploreParams{Novelty: NoveltyNew}))
+	assert.Empty(t, ActiveFilters(&VenueExploreParams{}))
+}
+
+func TestVenueExploreParams_ApplySuggestions(t *testing.T) {
+	params := &VenueExploreParams{Near: "Midtown", Radius: 5000, Query: "music"}
+	params.ApplySuggestions(&VenueExploreResp{
+		SuggestedRadius: 600,
+		SuggestedBounds: SuggestedBounds{Sw: LatLong{Lat: 40.7, Lng: -74}, Ne: LatLong{Lat: 40.8, Lng: -73.9}},
+	})
+	assert.Equal(t, &VenueExploreParams{LatLong: "40.75,-73.95", Radius: 600, Query: "music"}, params)
+
+	params = &VenueExploreParams{Near: "Midtown"}
+	params.ApplySuggestions(&VenueExploreResp{})
+	assert.Equal(t, &VenueExploreParams{Near: "Midtown"}, params)
+}
+
+func TestVenueService_ExploreWithFilters(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/v2/venues/explore", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQueryNoUser(t, map[string]string{
+			"near":         "Chicago, IL",
+			"price":        "1,2,3",
+			"friendVisits": "notvisited",
+			"openNow":      "1",
+		}, r)
+
+		b, err := getTestFile("./json/venues/explore.json")
+		if err != nil {
+			t.Fatalf("Failed to open testfile")
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+	})
+
+	params := &VenueExploreParams{Near: "Chicago, IL"}
+	assert.Nil(t, params.ApplyFilter(Filter{Name: "$-$$$", Key: FilterPrice}))
+	assert.Nil(t, params.ApplyFilter(Filter{Name: "Friends haven't been", Key: FilterFriendVisits}))
+	assert.Nil(t, params.ApplyFilter(Filter{Name: "Open now", Key: FilterOpenNow}))
+
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	_, _, err := client.Venues.Explore(params)
+	assert.Nil(t, err)
+}
@@ -0,0 +1,369 @@
+package foursquarego
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Column is a column of a venue export. Path is a dotted path of Venue
+// fields, "Location.City" or "Price.Tier" for example. A part of the path
+// can also be a method without arguments, such as PrimaryCategory, or an
+// index into a slice, such as "Categories.0.Name". Parts match the Go name
+// or the json name of a field and case doesn't matter.
+type Column struct {
+	Name string
+	Path string
+}
+
+// Columns makes a Column named after each path.
+func Columns(paths ...string) []Column {
+	columns := make([]Column, len(paths))
+	for i, p := range paths {
+		columns[i] = Column{Name: p, Path: p}
+	}
+	return columns
+}
+
+// DefaultVenueColumns is the schema used when no columns are given. It is
+// stable, new columns are only ever added at the end.
+var DefaultVenueColumns = []Column{
+	{"id", "ID"},
+	{"name", "Name"},
+	{"address", "Location.FormattedAddress"},
+	{"lat", "Location.Lat"},
+	{"lng", "Location.Lng"},
+	{"city", "Location.City"},
+	{"state", "Location.State"},
+	{"postal_code", "Location.PostalCode"},
+	{"cc", "Location.Cc"},
+	{"category_id", "PrimaryCategory.ID"},
+	{"category", "PrimaryCategory.Name"},
+	{"checkins", "Stats.CheckinsCount"},
+	{"users", "Stats.UsersCount"},
+	{"tips", "Stats.TipCount"},
+	{"visits", "Stats.VisitsCount"},
+	{"price_tier", "Price.Tier"},
+	{"rating", "Rating"},
+	{"phone", "Contact.FormattedPhone"},
+	{"twitter", "Contact.Twitter"},
+	{"facebook", "Contact.Facebook"},
+	{"instagram", "Contact.Instagram"},
+	{"url", "URL"},
+	{"hours_status", "Hours.Status"},
+}
+
+// VenueWriter writes venues one at a time. Call Flush when done.
+type VenueWriter interface {
+	Write(v Venue) error
+	Flush() error
+}
+
+// WriteVenues writes every venue to w and flushes it.
+func WriteVenues(w VenueWriter, venues []Venue) error {
+	for _, v := range venues {
+		if err := w.Write(v); err != nil {
+			return err
+		}
+	}
+	return w.Flush()
+}
+
+// StreamVenues writes the venues from a channel, a Crawler's out for
+// example, until it is closed and then flushes w. After an error the rest
+// of the channel is drained so the sender isn't blocked.
+func StreamVenues(w VenueWriter, venues <-chan Venue) error {
+	var err error
+	for v := range venues {
+		if err == nil {
+			err = w.Write(v)
+		}
+	}
+	if err != nil {
+		return err
+	}
+	return w.Flush()
+}
+
+// CSVWriter writes venues as csv with a header row.
+type CSVWriter struct {
+	w           *csv.Writer
+	columns     []Column
+	getters     []venueGetter
+	wroteHeader bool
+}
+
+// NewCSVWriter returns a CSVWriter for the columns, or DefaultVenueColumns
+// when there are none. It fails when a path doesn't exist on Venue.
+func NewCSVWriter(w io.Writer, columns ...Column) (*CSVWriter, error) {
+	if len(columns) == 0 {
+		columns = DefaultVenueColumns
+	}
+	getters, err := compileColumns(columns)
+	if err != nil {
+		return nil, err
+	}
+	return &CSVWriter{w: csv.NewWriter(w), columns: columns, getters: getters}, nil
+}
+
+// Write writes the row for a venue, after the header for the first one.
+func (c *CSVWriter) Write(v Venue) error {
+	if err := c.writeHeader(); err != nil {
+		return err
+	}
+
+	rv := reflect.ValueOf(v)
+	row := make([]string, len(c.getters))
+	for i, get := range c.getters {
+		row[i] = formatColumn(get(rv))
+	}
+	return c.w.Write(row)
+}
+
+// Flush writes any buffered rows. The header is written even when there
+// were no venues.
+func (c *CSVWriter) Flush() error {
+	if err := c.writeHeader(); err != nil {
+		return err
+	}
+	c.w.Flush()
+	return c.w.Error()
+}
+
+func (c *CSVWriter) writeHeader() error {
+	if c.wroteHeader {
+		return nil
+	}
+	c.wroteHeader = true
+
+	header := make([]string, len(c.columns))
+	for i, col := range c.columns {
+		header[i] = col.Name
+	}
+	return c.w.Write(header)
+}
+
+// NDJSONWriter writes venues as newline delimited json, one object per
+// venue with a key for each column in the order of the columns.
+type NDJSONWriter struct {
+	w       io.Writer
+	buf     bytes.Buffer
+	columns []Column
+	getters []venueGetter
+}
+
+// NewNDJSONWriter returns a NDJSONWriter for the columns, or
+// DefaultVenueColumns when there are none. It fails when a path doesn't
+// exist on Venue.
+func NewNDJSONWriter(w io.Writer, columns ...Column) (*NDJSONWriter, error) {
+	if len(columns) == 0 {
+		columns = DefaultVenueColumns
+	}
+	getters, err := compileColumns(columns)
+	if err != nil {
+		return nil, err
+	}
+	return &NDJSONWriter{w: w, columns: columns, getters: getters}, nil
+}
+
+// Write writes the line for a venue. Values keep their json type and a
+// path through a nil pointer or past the end of a slice is null.
+func (n *NDJSONWriter) Write(v Venue) error {
+	rv := reflect.ValueOf(v)
+	n.buf.Reset()
+	n.buf.WriteByte('{')
+	for i, get := range n.getters {
+		var value interface{}
+		if f := get(rv); f.IsValid() {
+			value = f.Interface()
+		}
+		key, err := json.Marshal(n.columns[i].Name)
+		if err != nil {
+			return err
+		}
+		b, err := json.Marshal(value)
+		if err != nil {
+			return err
+		}
+		if i > 0 {
+			n.buf.WriteByte(',')
+		}
+		n.buf.Write(key)
+		n.buf.WriteByte(':')
+		n.buf.Write(b)
+	}
+	n.buf.WriteString("}\n")
+	_, err := n.w.Write(n.buf.Bytes())
+	return err
+}
+
+// Flush does nothing, every line is written by Write.
+func (n *NDJSONWriter) Flush() error {
+	return nil
+}
+
+// venueGetter returns the value at a path of a Venue. The value is
+// invalid when the path passes through a nil pointer or a missing index.
+type venueGetter func(v reflect.Value) reflect.Value
+
+var (
+	venueType     = reflect.TypeOf(Venue{})
+	timeType      = reflect.TypeOf(time.Time{})
+	timestampType = reflect.TypeOf(Timestamp{})
+)
+
+func compileColumns(columns []Column) ([]venueGetter, error) {
+	getters := make([]venueGetter, len(columns))
+	for i, c := range columns {
+		get, err := compilePath(venueType, c.Path)
+		if err != nil {
+			return nil, err
+		}
+		getters[i] = get
+	}
+	return getters, nil
+}
+
+// compilePath resolves path against t once so each row only walks values.
+func compilePath(t reflect.Type, path string) (venueGetter, error) {
+	var steps []venueGetter
+	for _, part := range strings.Split(path, ".") {
+		step, next, err := pathStep(t, part)
+		if err != nil {
+			return nil, fmt.Errorf("foursquarego: column %q: %v", path, err)
+		}
+		steps = append(steps, step)
+		t = next
+	}
+
+	return func(v reflect.Value) reflect.Value {
+		for _, step := range steps {
+			for v.IsValid() && v.Kind() == reflect.Ptr {
+				if v.IsNil() {
+					return reflect.Value{}
+				}
+				v = v.Elem()
+			}
+			if !v.IsValid() {
+				return v
+			}
+			v = step(v)
+		}
+		return v
+	}, nil
+}
+
+func pathStep(t reflect.Type, part string) (venueGetter, reflect.Type, error) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	if t.Kind() == reflect.Struct {
+		if f, ok := fieldByName(t, part); ok {
+			return func(v reflect.Value) reflect.Value {
+				return v.FieldByIndex(f.Index)
+			}, f.Type, nil
+		}
+	}
+
+	if m, ok := methodByName(t, part); ok {
+		return func(v reflect.Value) reflect.Value {
+			return v.Method(m.Index).Call(nil)[0]
+		}, m.Type.Out(0), nil
+	}
+
+	if t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+		if i, err := strconv.Atoi(part); err == nil && i >= 0 {
+			return func(v reflect.Value) reflect.Value {
+				if i >= v.Len() {
+					return reflect.Value{}
+				}
+				return v.Index(i)
+			}, t.Elem(), nil
+		}
+	}
+	return nil, nil, fmt.Errorf("%s has no %s", t, part)
+}
+
+// methodByName finds an exported method with no arguments and one result.
+func methodByName(t reflect.Type, name string) (reflect.Method, bool) {
+	for i := 0; i < t.NumMethod(); i++ {
+		m := t.Method(i)
+		if strings.EqualFold(m.Name, name) && m.Type.NumIn() == 1 && m.Type.NumOut() == 1 {
+			return m, true
+		}
+	}
+	return reflect.Method{}, false
+}
+
+func fieldByName(t reflect.Type, name string) (reflect.StructField, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := strings.Split(f.Tag.Get("json"), ",")[0]
+		if f.PkgPath == "" && (strings.EqualFold(f.Name, name) || strings.EqualFold(tag, name)) {
+			return f, true
+		}
+	}
+	return t.FieldByNameFunc(func(n string) bool {
+		return strings.EqualFold(n, name)
+	})
+}
+
+// formatColumn turns a value into a csv cell. Lists of strings such as
+// Location.FormattedAddress are joined with ", " and anything that isn't
+// a plain value is written as json.
+func formatColumn(v reflect.Value) string {
+	for v.IsValid() && (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return ""
+		}
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return ""
+	}
+
+	if v.Type() == timestampType {
+		v = v.Field(0)
+	}
+	if v.Type() == timeType {
+		t := v.Interface().(time.Time)
+		if t.IsZero() {
+			return ""
+		}
+		return t.UTC().Format(time.RFC3339)
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String()
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64)
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() == reflect.String {
+			s := make([]string, v.Len())
+			for i := range s {
+				s[i] = v.Index(i).String()
+			}
+			return strings.Join(s, ", ")
+		}
+	}
+
+	b, err := json.Marshal(v.Interface())
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
@@ -0,0 +1,88 @@
+package foursquarego
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testVenueDetails(t *testing.T) Venue {
+	b, err := getTestFile("./json/venues/details.json")
+	assert.Nil(t, err)
+
+	var r Response
+	assert.Nil(t, json.Unmarshal(b, &r))
+	venue := new(venueResp)
+	assert.Nil(t, json.Unmarshal(r.Response, venue))
+	return venue.Venue
+}
+
+func TestCSVWriter_DefaultColumns(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewCSVWriter(&buf)
+	assert.Nil(t, err)
+	assert.Nil(t, WriteVenues(w, []Venue{testVenueDetails(t), {ID: "empty"}}))
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	assert.Nil(t, err)
+	assert.Len(t, records, 3)
+	assert.Equal(t, []string{
+		"id", "name", "address", "lat", "lng", "city", "state", "postal_code", "cc",
+		"category_id", "category", "checkins", "users", "tips", "visits", "price_tier",
+		"rating", "phone", "twitter", "facebook", "instagram", "url", "hours_status",
+	}, records[0])
+	assert.Equal(t, []string{
+		"5414d0a6498ea3d31a3c64cf", "Threes Brewing", "333 Douglass St (at 4th Ave), Brooklyn, NY 11217",
+		"40.67979901271337", "-73.98215935484912", "Brooklyn", "NY", "11217", "US",
+		"50327c8591d4c4b30a586d5d", "Brewery", "15477", "12756", "165", "25836", "2",
+		"9.4", "(718) 522-2110", "threesbrewing", "1494258594141562", "threesbrewing",
+		"http://www.threesbrewing.com", "Open until 2:00 AM",
+	}, records[1])
+	assert.Equal(t, "empty", records[2][0])
+	assert.Equal(t, "", records[2][9])
+}
+
+func TestCSVWriter_Columns(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewCSVWriter(&buf, append(Columns("location.city", "Categories.1.name", "Categories.5.Name", "CreatedAt"),
+		Column{Name: "lat", Path: "Position.Lat"})...)
+	assert.Nil(t, err)
+	assert.Nil(t, WriteVenues(w, []Venue{testVenueDetails(t)}))
+	assert.Equal(t, "location.city,Categories.1.name,Categories.5.Name,CreatedAt,lat\n"+
+		"Brooklyn,Bar,,2014-09-13T23:17:58Z,40.67979901271337\n", buf.String())
+
+	buf.Reset()
+	w, err = NewCSVWriter(&buf, Columns("id")...)
+	assert.Nil(t, err)
+	assert.Nil(t, w.Flush())
+	assert.Equal(t, "id\n", buf.String())
+
+	_, err = NewCSVWriter(&buf, Columns("Location.Nope")...)
+	assert.EqualError(t, err, `foursquarego: column "Location.Nope": foursquarego.Location has no Nope`)
+}
+
+func TestNDJSONWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewNDJSONWriter(&buf, Column{"id", "ID"}, Column{"tier", "Price.Tier"}, Column{"category", "PrimaryCategory.Name"})
+	assert.Nil(t, err)
+
+	venues := make(chan Venue)
+	go func() {
+		venues <- testVenueDetails(t)
+		venues <- Venue{ID: "empty"}
+		close(venues)
+	}()
+	assert.Nil(t, StreamVenues(w, venues))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	assert.Len(t, lines, 2)
+	assert.JSONEq(t, `{"id": "5414d0a6498ea3d31a3c64cf", "tier": 2, "category": "Brewery"}`, lines[0])
+	assert.JSONEq(t, `{"id": "empty", "tier": 0, "category": null}`, lines[1])
+
+	// Keys are in column order, not sorted.
+	assert.Equal(t, `{"id":"empty","tier":0,"category":null}`, lines[1])
+}
@@ -0,0 +1,237 @@
+package foursquarego
+
+import (
+	"strings"
+)
+
+// VenueFeatures is a typed view of a venue's Attributes so they don't need
+// their display strings parsed. A false or empty field means foursquare
+// said no or didn't say. Groups that aren't understood are kept in Other
+// by their type.
+type VenueFeatures struct {
+	PriceTier            int
+	Reservations         bool
+	CreditCards          bool
+	Cards                []string
+	Wifi                 WifiAccess
+	OutdoorSeating       bool
+	Parking              []ParkingType
+	Music                []MusicType
+	DiningOptions        []DiningOption
+	Menus                []string
+	Drinks               []string
+	WheelchairAccessible bool
+	Other                map[string]Attribute
+}
+
+// WifiAccess is the Wi-Fi a venue has.
+type WifiAccess string
+
+// Options for WifiAccess
+const (
+	WifiNone      WifiAccess = ""
+	WifiFree      WifiAccess = "free"
+	WifiPaid      WifiAccess = "paid"
+	WifiAvailable WifiAccess = "available"
+)
+
+// ParkingType is a kind of parking at a venue.
+type ParkingType string
+
+// Options for ParkingType
+const (
+	ParkingStreet    ParkingType = "street"
+	ParkingLot       ParkingType = "lot"
+	ParkingPublicLot ParkingType = "publicLot"
+	ParkingGarage    ParkingType = "garage"
+	ParkingValet     ParkingType = "valet"
+)
+
+// MusicType is a kind of music played at a venue.
+type MusicType string
+
+// Options for MusicType
+const (
+	MusicLive     MusicType = "live"
+	MusicJukebox  MusicType = "jukebox"
+	MusicDJ       MusicType = "dj"
+	MusicRecorded MusicType = "recorded"
+)
+
+// DiningOption is a way a venue serves food.
+type DiningOption string
+
+// Options for DiningOption
+const (
+	DiningTakeOut   DiningOption = "takeOut"
+	DiningDelivery  DiningOption = "delivery"
+	DiningDriveThru DiningOption = "driveThru"
+	DiningDineIn    DiningOption = "dineIn"
+)
+
+// Features returns the typed VenueFeatures of the attributes.
+func (a Attributes) Features() VenueFeatures {
+	f := VenueFeatures{Other: make(map[string]Attribute)}
+	for _, group := range a.Groups {
+		parse, ok := featureParsers[group.Type]
+		if !ok {
+			f.Other[group.Type] = group
+			continue
+		}
+		parse(&f, group)
+	}
+	return f
+}
+
+// Features returns the typed VenueFeatures of the venue's Attributes. The
+// price tier comes from Price when the attributes don't have it.
+func (v Venue) Features() VenueFeatures {
+	f := v.Attributes.Features()
+	if f.PriceTier == 0 {
+		f.PriceTier = v.Price.Tier
+	}
+	return f
+}
+
+var featureParsers = map[string]func(f *VenueFeatures, a Attribute){
+	"price": func(f *VenueFeatures, a Attribute) {
+		for _, item := range a.Items {
+			if item.PriceTier > 0 {
+				f.PriceTier = item.PriceTier
+			}
+		}
+	},
+	"reservations": func(f *VenueFeatures, a Attribute) {
+		f.Reservations = anyAttributeYes(a)
+	},
+	"payments": func(f *VenueFeatures, a Attribute) {
+		for _, item := range a.Items {
+			if !attributeYes(item) || !strings.Contains(strings.ToLower(item.DisplayName), "credit card") {
+				continue
+			}
+			f.CreditCards = true
+			f.Cards = append(f.Cards, includedCards(item.DisplayValue)...)
+		}
+	},
+	"wifi": func(f *VenueFeatures, a Attribute) {
+		for _, item := range a.Items {
+			value := strings.ToLower(item.DisplayValue)
+			switch {
+			case !attributeYes(item):
+			case strings.Contains(value, "free"):
+				f.Wifi = WifiFree
+			case strings.Contains(value, "paid"):
+				f.Wifi = WifiPaid
+			default:
+				f.Wifi = WifiAvailable
+			}
+		}
+	},
+	"outdoorSeating": func(f *VenueFeatures, a Attribute) {
+		f.OutdoorSeating = anyAttributeYes(a)
+	},
+	"parking": func(f *VenueFeatures, a Attribute) {
+		for _, i := range matchAttributes(a, parkingKeywords) {
+			f.Parking = append(f.Parking, parkingTypes[i])
+		}
+	},
+	"music": func(f *VenueFeatures, a Attribute) {
+		for _, i := range matchAttributes(a, musicKeywords) {
+			f.Music = append(f.Music, musicTypes[i])
+		}
+	},
+	"diningOptions": func(f *VenueFeatures, a Attribute) {
+		for _, i := range matchAttributes(a, diningKeywords) {
+			f.DiningOptions = append(f.DiningOptions, diningOptions[i])
+		}
+	},
+	"serves": func(f *VenueFeatures, a Attribute) {
+		f.Menus = attributeNames(a)
+	},
+	"drinks": func(f *VenueFeatures, a Attribute) {
+		f.Drinks = attributeNames(a)
+	},
+	"wheelchairAccessible": func(f *VenueFeatures, a Attribute) {
+		f.WheelchairAccessible = anyAttributeYes(a)
+	},
+}
+
+// The keywords looked for in the items of a group and what each means.
+// Longer keywords go first so "lot" isn't also found in "public lot".
+var (
+	parkingKeywords = []string{"public lot", "street", "lot", "garage", "valet"}
+	parkingTypes    = []ParkingType{ParkingPublicLot, ParkingStreet, ParkingLot, ParkingGarage, ParkingValet}
+	musicKeywords   = []string{"live", "jukebox", "dj", "recorded"}
+	musicTypes      = []MusicType{MusicLive, MusicJukebox, MusicDJ, MusicRecorded}
+	diningKeywords  = []string{"take-out", "delivery", "drive-thru", "dine-in"}
+	diningOptions   = []DiningOption{DiningTakeOut, DiningDelivery, DiningDriveThru, DiningDineIn}
+)
+
+// attributeYes is false for items shown as "No" and true for everything
+// else, "Yes (incl. AmEx)" or "Live Music" for example.
+func attributeYes(item AttributeItem) bool {
+	value := strings.ToLower(strings.TrimSpace(item.DisplayValue))
+	return value != "no" && !strings.HasPrefix(value, "no ") && !strings.HasPrefix(value, "no,")
+}
+
+func anyAttributeYes(a Attribute) bool {
+	for _, item := range a.Items {
+		if attributeYes(item) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchAttributes returns the index of every keyword found in the items
+// that aren't "No", each index once. A keyword's text is taken out once it
+// is found so shorter keywords inside it aren't found again.
+func matchAttributes(a Attribute, keywords []string) []int {
+	var found []int
+	seen := make(map[int]bool)
+	for _, item := range a.Items {
+		if !attributeYes(item) {
+			continue
+		}
+		text := strings.ToLower(item.DisplayName + " " + item.DisplayValue)
+		for i, k := range keywords {
+			if strings.Contains(text, k) {
+				if !seen[i] {
+					seen[i] = true
+					found = append(found, i)
+				}
+				text = strings.Replace(text, k, " ", -1)
+			}
+		}
+	}
+	return found
+}
+
+func attributeNames(a Attribute) []string {
+	var names []string
+	for _, item := range a.Items {
+		if attributeYes(item) {
+			names = append(names, item.DisplayName)
+		}
+	}
+	return names
+}
+
+// includedCards reads the cards out of "Yes (incl. American Express &
+// MasterCard)".
+func includedCards(value string) []string {
+	start := strings.Index(value, "(incl.")
+	end := strings.LastIndex(value, ")")
+	if start < 0 || end < start {
+		return nil
+	}
+
+	var cards []string
+	list := strings.Replace(value[start+len("(incl."):end], " & ", ", ", -1)
+	for _, card := range strings.Split(list, ",") {
+		if card = strings.TrimSpace(card); card != "" {
+			cards = append(cards, card)
+		}
+	}
+	return cards
+}
@@ -0,0 +1,76 @@
+package foursquarego
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVenue_Features(t *testing.T) {
+	f := testVenueDetails(t).Features()
+
+	assert.Equal(t, 2, f.PriceTier)
+	assert.Equal(t, true, f.CreditCards)
+	assert.Equal(t, []string{"American Express", "MasterCard"}, f.Cards)
+	assert.Equal(t, true, f.OutdoorSeating)
+	assert.Equal(t, []MusicType{MusicLive}, f.Music)
+	assert.Equal(t, WifiFree, f.Wifi)
+	assert.Equal(t, []string{"Brunch", "Dinner", "Happy Hour"}, f.Menus)
+	assert.Equal(t, []string{"Beer", "Wine", "Full Bar", "Cocktails"}, f.Drinks)
+	assert.Equal(t, false, f.Reservations)
+	assert.Nil(t, f.Parking)
+	assert.Empty(t, f.Other)
+}
+
+func TestAttributes_Features(t *testing.T) {
+	group := func(kind string, items ...AttributeItem) Attribute {
+		return Attribute{Group: Group{Type: kind, Name: kind}, Items: items}
+	}
+	item := func(name, value string) AttributeItem {
+		return AttributeItem{DisplayName: name, DisplayValue: value}
+	}
+
+	f := Attributes{Groups: []Attribute{
+		group("reservations", item("Reservations", "Yes")),
+		group("payments", item("Credit Cards", "No")),
+		group("wifi", item("Wi-Fi", "Yes")),
+		group("outdoorSeating", item("Outdoor Seating", "No")),
+		group("parking", item("Parking", "Street"), item("Public Lot", "Public Lot"), item("Valet", "No"), item("Lot", "Lot")),
+		group("music", item("DJ", "DJ"), item("Jukebox", "Jukebox")),
+		group("diningOptions", item("Take-out", "Yes"), item("Delivery", "No"), item("Dine-in", "Dine-in")),
+		group("wheelchairAccessible", item("Wheelchair Accessible", "Yes")),
+		group("restroom", item("Restroom", "Yes")),
+	}}.Features()
+
+	assert.Equal(t, true, f.Reservations)
+	assert.Equal(t, false, f.CreditCards)
+	assert.Nil(t, f.Cards)
+	assert.Equal(t, WifiAvailable, f.Wifi)
+	assert.Equal(t, false, f.OutdoorSeating)
+	assert.Equal(t, []ParkingType{ParkingStreet, ParkingPublicLot, ParkingLot}, f.Parking)
+	assert.Equal(t, []MusicType{MusicDJ, MusicJukebox}, f.Music)
+	assert.Equal(t, []DiningOption{DiningTakeOut, DiningDineIn}, f.DiningOptions)
+	assert.Equal(t, true, f.WheelchairAccessible)
+	assert.Equal(t, 0, f.PriceTier)
+	assert.Equal(t, "Yes", f.Other["restroom"].Items[0].DisplayValue)
+
+	assert.Equal(t, WifiNone, Attributes{Groups: []Attribute{group("wifi", item("Wi-Fi", "No"))}}.Features().Wifi)
+	assert.Equal(t, WifiPaid, Attributes{Groups: []Attribute{group("wifi", item("Wi-Fi", "Paid"))}}.Features().Wifi)
+	assert.Equal(t, 3, Venue{Price: Price{Tier: 3}}.Features().PriceTier)
+}
+
+func TestAttributes_FeaturesMultiValue(t *testing.T) {
+	f := Attributes{Groups: []Attribute{
+		{Group: Group{Type: "parking"}, Items: []AttributeItem{{DisplayName: "Parking", DisplayValue: "Street, Private Lot"}}},
+		{Group: Group{Type: "diningOptions"}, Items: []AttributeItem{{DisplayName: "Dining Options", DisplayValue: "Take-out, Delivery, Dine-in"}}},
+		{Group: Group{Type: "payments"}, Items: []AttributeItem{
+			{DisplayName: "Credit Cards", DisplayValue: "No"},
+			{DisplayName: "Digital Payments", DisplayValue: "Yes"},
+		}},
+	}}.Features()
+
+	assert.Equal(t, []ParkingType{ParkingStreet, ParkingLot}, f.Parking)
+	assert.Equal(t, []DiningOption{DiningTakeOut, DiningDelivery, DiningDineIn}, f.DiningOptions)
+	assert.Equal(t, false, f.CreditCards)
+	assert.Nil(t, f.Cards)
+}
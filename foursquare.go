@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/dghubble/sling"
 )
@@ -14,6 +16,7 @@ const (
 	headerRateLimit     = "X-RateLimit-Limit"
 	headerRateRemaining = "x-RateLimit-Remaining"
 	headerRatePath      = "X-RateLimit-Path"
+	notificationTray    = "notificationTray"
 )
 
 // Client is a Foursquare client for making Foursquare API requests.
@@ -21,7 +24,14 @@ type Client struct {
 	sling *sling.Sling
 
 	// Services used for talking to different parts of the API
-	Venues *VenueService
+	Venues      *VenueService
+	Lists       *ListService
+	Tips        *TipService
+	Photos      *PhotoService
+	Events      *EventService
+	Pages       *PageService
+	VenueGroups *VenueGroupService
+	Updates     *UpdateService
 }
 
 // NewClient returns a new Client.
@@ -42,8 +52,15 @@ func NewClient(httpClient *http.Client, mode, clientID, clientSecret, accessToke
 	})
 
 	return &Client{
-		sling:  b,
-		Venues: newVenueService(b.New()),
+		sling:       b,
+		Venues:      newVenueService(b.New()),
+		Lists:       newListService(b.New()),
+		Tips:        newTipService(b.New()),
+		Photos:      newPhotoService(b.New()),
+		Events:      newEventService(b.New()),
+		Pages:       newPageService(b.New()),
+		VenueGroups: newVenueGroupService(b.New()),
+		Updates:     newUpdateService(b.New()),
 	}
 }
 
@@ -72,11 +89,55 @@ type Meta struct {
 	RequestID   string `json:"requestId"`
 }
 
-// Notification comes with all responses.
+// UnreadCount returns the unread count from the notificationTray
+// notification that comes with the response.
+func (r *Response) UnreadCount() (int, bool) {
+	for _, n := range r.Notifications {
+		if n.Type == notificationTray {
+			return n.UnreadCount, true
+		}
+	}
+	return 0, false
+}
+
+// Notification comes with all responses. UnreadCount is read from the
+// Item of the notificationTray notification.
 // https://developer.foursquare.com/docs/responses/notifications
 type Notification struct {
-	Type string  `json:"type"`
-	Item Omitted `json:"item"`
+	Type        string  `json:"type"`
+	Item        Omitted `json:"item"`
+	UnreadCount int     `json:"-"`
+}
+
+// UnmarshalJSON decodes the notification and types the unread count when
+// it is a notificationTray.
+func (n *Notification) UnmarshalJSON(b []byte) error {
+	type notification Notification
+	var raw struct {
+		notification
+		Item json.RawMessage `json:"item"`
+	}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+
+	*n = Notification(raw.notification)
+	if len(raw.Item) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(raw.Item, &n.Item); err != nil {
+		return err
+	}
+	if n.Type == notificationTray {
+		var tray struct {
+			UnreadCount int `json:"unreadCount"`
+		}
+		if err := json.Unmarshal(raw.Item, &tray); err != nil {
+			return err
+		}
+		n.UnreadCount = tray.UnreadCount
+	}
+	return nil
 }
 
 // Group contains the default fields in a group. A lot of responses
@@ -111,6 +172,53 @@ const (
 	True = BoolAsAnInt(1)
 )
 
+// Timestamp is a time that foursquare sends as seconds since the epoch.
+// It marshals back to the same form so responses can be stored and re-read.
+type Timestamp struct {
+	time.Time
+}
+
+// UnmarshalJSON reads epoch seconds into the Timestamp. A null or 0 value
+// leaves the Timestamp as the zero time.
+func (t *Timestamp) UnmarshalJSON(b []byte) error {
+	s := strings.Trim(string(b), `"`)
+	if s == "null" || s == "" {
+		t.Time = time.Time{}
+		return nil
+	}
+
+	sec, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	if sec == 0 {
+		t.Time = time.Time{}
+		return nil
+	}
+	t.Time = time.Unix(sec, 0)
+	return nil
+}
+
+// MarshalJSON writes the Timestamp as epoch seconds. The zero time is
+// written as 0.
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("0"), nil
+	}
+	return []byte(strconv.FormatInt(t.Unix(), 10)), nil
+}
+
+// InZone returns the time in the named IANA time zone such as the
+// TimeZone on a Venue or Event, "America/New_York" for example.
+func (t Timestamp) InZone(name string) (time.Time, error) {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return t.Time, err
+	}
+	return t.In(loc), nil
+}
+
 // RateLimit is a struct of foursquare ratelimit data
 type RateLimit struct {
 	Limit     int
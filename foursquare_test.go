@@ -1,12 +1,14 @@
 package foursquarego
 
 import (
+	"encoding/json"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -57,6 +59,16 @@ func assertQueryNoUser(t *testing.T, expected map[string]string, req *http.Reque
 	assert.Equal(t, expectedValues, queryValues)
 }
 
+func assertForm(t *testing.T, expected map[string]string, req *http.Request) {
+	assert.Nil(t, req.ParseForm())
+
+	expectedValues := url.Values{}
+	for key, value := range expected {
+		expectedValues.Add(key, value)
+	}
+	assert.Equal(t, expectedValues, req.PostForm)
+}
+
 func getTestFile(path string) ([]byte, error) {
 	// Open file with sample json
 	f, err := os.Open(path)
@@ -83,3 +95,49 @@ func TestRateLimit(t *testing.T) {
 	assert.Equal(t, "/v2/venues/X", rl.Path)
 	assert.Equal(t, 4999, rl.Remaining)
 }
+
+func TestTimestamp(t *testing.T) {
+	var v struct {
+		At    Timestamp `json:"at"`
+		Empty Timestamp `json:"empty"`
+	}
+	err := json.Unmarshal([]byte(`{"at":1410650278,"empty":0}`), &v)
+	assert.Nil(t, err)
+
+	assert.Equal(t, int64(1410650278), v.At.Unix())
+	assert.True(t, v.Empty.IsZero())
+
+	local, err := v.At.InZone("America/New_York")
+	assert.Nil(t, err)
+	assert.Equal(t, "2014-09-13T19:17:58-04:00", local.Format(time.RFC3339))
+
+	b, err := json.Marshal(v)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"at":1410650278,"empty":0}`, string(b))
+
+	err = json.Unmarshal([]byte(`{"at":"soon"}`), &v)
+	assert.NotNil(t, err)
+}
+
+func TestNotification(t *testing.T) {
+	b, err := getTestFile("./json/updates/details.json")
+	assert.Nil(t, err)
+
+	var r Response
+	err = json.Unmarshal(b, &r)
+	assert.Nil(t, err)
+
+	assert.Equal(t, "notificationTray", r.Notifications[0].Type)
+	assert.Equal(t, 3, r.Notifications[0].UnreadCount)
+	assert.Equal(t, map[string]interface{}{"unreadCount": float64(3)}, r.Notifications[0].Item)
+
+	count, ok := r.UnreadCount()
+	assert.True(t, ok)
+	assert.Equal(t, 3, count)
+
+	err = json.Unmarshal([]byte(`{"notifications":[{"type":"message","item":{"message":"Hi"}}]}`), &r)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, r.Notifications[0].UnreadCount)
+	_, ok = r.UnreadCount()
+	assert.False(t, ok)
+}
@@ -0,0 +1,256 @@
+package foursquaretest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Mode is how a Recorder handles requests.
+type Mode int
+
+// Options for a Mode
+const (
+	// ModeReplay answers requests from the cassette and never touches the
+	// network. A request missing from the cassette is an error.
+	ModeReplay Mode = iota
+	// ModeRecord sends requests to foursquare and writes every
+	// interaction to the cassette when the Recorder is closed.
+	ModeRecord
+	// ModePassthrough sends requests to foursquare without recording.
+	ModePassthrough
+)
+
+// scrubbed are the query parameters removed from recorded URLs.
+var scrubbed = []string{"client_secret", "oauth_token", "access_token"}
+
+// Cassette is the file a Recorder reads and writes.
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is a single request and its response.
+type Interaction struct {
+	Request  CassetteRequest  `json:"request"`
+	Response CassetteResponse `json:"response"`
+}
+
+// CassetteRequest is a recorded request with the credentials removed
+// from its URL. The Body is kept as bytes, base64 in the json, so binary
+// uploads are stored as they were sent.
+type CassetteRequest struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Body   []byte `json:"body,omitempty"`
+}
+
+// CassetteResponse is a recorded response.
+type CassetteResponse struct {
+	StatusCode int         `json:"statusCode"`
+	Header     http.Header `json:"header"`
+	Body       string      `json:"body"`
+}
+
+// Recorder is an http.RoundTripper that records foursquare responses to a
+// cassette file and replays them later. Use it through Client or set it as
+// the Transport of the http.Client given to foursquarego.NewClient, and
+// Close it to write what was recorded.
+//
+// Requests are matched on method, path and query. Credentials and the
+// client_id are left out when matching so a cassette recorded with one
+// app's keys can be replayed with another's.
+type Recorder struct {
+	// Transport makes the real requests in ModeRecord and ModePassthrough.
+	// http.DefaultTransport is used when nil.
+	Transport http.RoundTripper
+
+	mode     Mode
+	path     string
+	mu       sync.Mutex
+	cassette Cassette
+	used     []bool
+}
+
+// NewRecorder returns a Recorder for the cassette at path. In ModeReplay
+// the cassette must already exist. In ModeRecord it is replaced.
+func NewRecorder(path string, mode Mode) (*Recorder, error) {
+	r := &Recorder{mode: mode, path: path}
+	if mode != ModeReplay {
+		return r, nil
+	}
+
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(b, &r.cassette); err != nil {
+		return nil, fmt.Errorf("foursquaretest: reading cassette %s: %v", path, err)
+	}
+	r.used = make([]bool, len(r.cassette.Interactions))
+	return r, nil
+}
+
+// Client returns an http.Client that uses the Recorder.
+func (r *Recorder) Client() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// RoundTrip handles the request according to the Recorder's Mode.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	switch r.mode {
+	case ModeReplay:
+		return r.replay(req)
+	case ModeRecord:
+		return r.record(req)
+	default:
+		return r.transport().RoundTrip(req)
+	}
+}
+
+func (r *Recorder) transport() http.RoundTripper {
+	if r.Transport != nil {
+		return r.Transport
+	}
+	return http.DefaultTransport
+}
+
+func (r *Recorder) replay(req *http.Request) (*http.Response, error) {
+	key := matchKey(req.Method, req.URL)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// Prefer interactions not yet replayed so a request made twice can get
+	// two different recorded responses.
+	found := -1
+	for i, in := range r.cassette.Interactions {
+		u, err := url.Parse(in.Request.URL)
+		if err != nil || matchKey(in.Request.Method, u) != key {
+			continue
+		}
+		if !r.used[i] {
+			found = i
+			break
+		}
+		if found < 0 {
+			found = i
+		}
+	}
+	if found < 0 {
+		return nil, fmt.Errorf("foursquaretest: no interaction in cassette %s for %s", r.path, key)
+	}
+	r.used[found] = true
+
+	rec := r.cassette.Interactions[found].Response
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", rec.StatusCode, http.StatusText(rec.StatusCode)),
+		StatusCode:    rec.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        rec.Header,
+		Body:          ioutil.NopCloser(strings.NewReader(rec.Body)),
+		ContentLength: int64(len(rec.Body)),
+		Request:       req,
+	}, nil
+}
+
+func (r *Recorder) record(req *http.Request) (*http.Response, error) {
+	var reqBody []byte
+	if req.Body != nil {
+		var err error
+		if reqBody, err = ioutil.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+		req.Body.Close()
+		req.Body = ioutil.NopCloser(bytes.NewReader(reqBody))
+	}
+
+	resp, err := r.transport().RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(respBody))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{
+		Request: CassetteRequest{
+			Method: req.Method,
+			URL:    scrub(req.URL).String(),
+			Body:   reqBody,
+		},
+		Response: CassetteResponse{
+			StatusCode: resp.StatusCode,
+			Header:     resp.Header,
+			Body:       string(respBody),
+		},
+	})
+	return resp, nil
+}
+
+// Close writes the cassette in ModeRecord. It does nothing in the other
+// modes.
+func (r *Recorder) Close() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	b, err := json.MarshalIndent(r.cassette, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(r.path), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(r.path, b, 0644)
+}
+
+// scrub returns a copy of u without the credential parameters.
+func scrub(u *url.URL) *url.URL {
+	c := *u
+	q := c.Query()
+	for _, key := range scrubbed {
+		q.Del(key)
+	}
+	c.RawQuery = q.Encode()
+	return &c
+}
+
+// matchKey is the method, path and sorted query of a request without the
+// credentials and client_id.
+func matchKey(method string, u *url.URL) string {
+	q := scrub(u).Query()
+	q.Del("client_id")
+
+	keys := make([]string, 0, len(q))
+	for k := range q {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var parts []string
+	for _, k := range keys {
+		vals := append([]string(nil), q[k]...)
+		sort.Strings(vals)
+		for _, v := range vals {
+			parts = append(parts, url.QueryEscape(k)+"="+url.QueryEscape(v))
+		}
+	}
+	return method + " " + u.Path + "?" + strings.Join(parts, "&")
+}
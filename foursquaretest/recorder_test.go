@@ -0,0 +1,89 @@
+package foursquaretest_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/peppage/foursquarego"
+	"github.com/peppage/foursquarego/foursquaretest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecorder(t *testing.T) {
+	dir, err := ioutil.TempDir("", "foursquaretest")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	cassette := filepath.Join(dir, "venues", "details.json")
+
+	server := foursquaretest.NewServer("../json")
+	rec, err := foursquaretest.NewRecorder(cassette, foursquaretest.ModeRecord)
+	assert.Nil(t, err)
+	rec.Transport = server.Client().Transport
+
+	client := foursquarego.NewClient(rec.Client(), "foursquare", "ci", "secret", "")
+	venue, _, err := client.Venues.Details("5414d0a6498ea3d31a3c64cf")
+	assert.Nil(t, err)
+	assert.Equal(t, "Threes Brewing", venue.Name)
+	server.Close()
+
+	_, err = os.Stat(cassette)
+	assert.True(t, os.IsNotExist(err))
+	assert.Nil(t, rec.Close())
+
+	b, err := ioutil.ReadFile(cassette)
+	assert.Nil(t, err)
+	assert.False(t, strings.Contains(string(b), "secret"))
+	assert.True(t, strings.Contains(string(b), "client_id=ci"))
+
+	rec, err = foursquaretest.NewRecorder(cassette, foursquaretest.ModeReplay)
+	assert.Nil(t, err)
+
+	client = foursquarego.NewClient(rec.Client(), "foursquare", "other", "", "token")
+	venue, resp, err := client.Venues.Details("5414d0a6498ea3d31a3c64cf")
+	assert.Nil(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Equal(t, "Threes Brewing", venue.Name)
+
+	_, _, err = client.Venues.Details("4fa89bb2e4b0bad89524b84a")
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "no interaction in cassette")
+		assert.Contains(t, err.Error(), "GET /v2/venues/4fa89bb2e4b0bad89524b84a")
+	}
+}
+
+func TestRecorder_BinaryBody(t *testing.T) {
+	dir, err := ioutil.TempDir("", "foursquaretest")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	cassette := filepath.Join(dir, "photo.json")
+
+	server := foursquaretest.NewServer("")
+	defer server.Close()
+	rec, err := foursquaretest.NewRecorder(cassette, foursquaretest.ModeRecord)
+	assert.Nil(t, err)
+	rec.Transport = server.Client().Transport
+
+	body := []byte{0xff, 0xd8, 0xff, 0xe0, 0x00, 0x10, 'J', 'F', 'I', 'F', 0x00, 0x80}
+	resp, err := rec.Client().Post("https://api.foursquare.com/v2/photos/add", "image/jpeg", bytes.NewReader(body))
+	assert.Nil(t, err)
+	resp.Body.Close()
+	assert.Nil(t, rec.Close())
+
+	b, err := ioutil.ReadFile(cassette)
+	assert.Nil(t, err)
+	var c foursquaretest.Cassette
+	assert.Nil(t, json.Unmarshal(b, &c))
+	if assert.Len(t, c.Interactions, 1) {
+		assert.Equal(t, body, c.Interactions[0].Request.Body)
+	}
+}
+
+func TestRecorder_MissingCassette(t *testing.T) {
+	_, err := foursquaretest.NewRecorder(filepath.Join("testdata", "missing.json"), foursquaretest.ModeReplay)
+	assert.NotNil(t, err)
+}
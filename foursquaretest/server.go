@@ -0,0 +1,289 @@
+/*
+Package foursquaretest provides a fake Foursquare API server for testing code
+that uses foursquarego.
+
+	server := foursquaretest.NewServer("path/to/foursquarego/json")
+	defer server.Close()
+
+	client := foursquarego.NewClient(server.Client(), "foursquare", "clientId", "clientSecret", "")
+	venue, resp, err := client.Venues.Details("5414d0a6498ea3d31a3c64cf")
+
+The server answers the venue endpoints with the json files used by the
+foursquarego tests, read from the directory given to NewServer. Other responses can be registered with Handle, HandleFile
+and HandleError. Every request is recorded and can be checked with Requests.
+
+Recorder is an http.RoundTripper that records real foursquare responses to
+a cassette file, written when it is closed, and replays them, which is how
+new fixtures can be captured.
+*/
+package foursquaretest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Request is a request the Server received.
+type Request struct {
+	Method string
+	Path   string
+	Query  url.Values
+	Header http.Header
+	Body   []byte
+}
+
+// Server is a fake Foursquare API. It checks that each request has the
+// v and client_id parameters and either client_secret or a user token.
+type Server struct {
+	*httptest.Server
+
+	mu        sync.Mutex
+	routes    []route
+	requests  []Request
+	limit     int
+	remaining int
+}
+
+type route struct {
+	method   string
+	segments []string
+	status   int
+	body     []byte
+	file     string
+}
+
+// venueFixtures are the routes answered by NewServer. A * matches any
+// single path segment.
+var venueFixtures = []struct {
+	path string
+	file string
+}{
+	{"/v2/venues/categories", "categories.json"},
+	{"/v2/venues/search", "search.json"},
+	{"/v2/venues/suggestCompletion", "suggest.json"},
+	{"/v2/venues/trending", "trending.json"},
+	{"/v2/venues/explore", "explore.json"},
+	{"/v2/venues/*", "details.json"},
+	{"/v2/venues/*/photos", "photos.json"},
+	{"/v2/venues/*/events", "events.json"},
+	{"/v2/venues/*/hours", "hours.json"},
+	{"/v2/venues/*/likes", "likes.json"},
+	{"/v2/venues/*/links", "links.json"},
+	{"/v2/venues/*/listed", "listed.json"},
+	{"/v2/venues/*/nextvenues", "nextvenues.json"},
+	{"/v2/venues/*/menu", "menu.json"},
+	{"/v2/venues/*/tips", "tips.json"},
+}
+
+// NewServer starts a Server that answers the venue endpoints with the
+// foursquarego json fixtures in fixtureDir, the json directory of the
+// foursquarego source. With no fixtureDir only the routes that are
+// registered are answered. Close it when done.
+func NewServer(fixtureDir string) *Server {
+	s := &Server{
+		limit:     5000,
+		remaining: 5000,
+	}
+	if fixtureDir != "" {
+		for _, f := range venueFixtures {
+			s.HandleFile("GET", f.path, filepath.Join(fixtureDir, "venues", f.file))
+		}
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serve))
+	return s
+}
+
+// Client returns an http.Client that sends every request to the Server,
+// whatever host it was made for. Pass it to foursquarego.NewClient.
+func (s *Server) Client() *http.Client {
+	u, _ := url.Parse(s.URL)
+	return &http.Client{Transport: &rewriteTransport{host: u.Host}}
+}
+
+type rewriteTransport struct {
+	host string
+}
+
+func (t *rewriteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	r := new(http.Request)
+	*r = *req
+	r.URL = new(url.URL)
+	*r.URL = *req.URL
+	r.URL.Scheme = "http"
+	r.URL.Host = t.host
+	r.Host = t.host
+	return http.DefaultTransport.RoundTrip(r)
+}
+
+// Handle answers requests to path with status and body. A * in path
+// matches any single segment, "/v2/lists/*" for example. Later
+// registrations replace earlier ones for the same method and path.
+func (s *Server) Handle(method, path string, status int, body []byte) {
+	s.addRoute(route{method: method, segments: split(path), status: status, body: body})
+}
+
+// HandleFile answers requests to path with the contents of file. The file
+// is read for every request so it can be changed while the Server runs.
+func (s *Server) HandleFile(method, path, file string) {
+	s.addRoute(route{method: method, segments: split(path), status: http.StatusOK, file: file})
+}
+
+// HandleError answers requests to path with an error meta the same way
+// foursquare does, for example HandleError("GET", "/v2/venues/*", 404,
+// "param_error", "Value is invalid for venue id").
+func (s *Server) HandleError(method, path string, code int, errorType, errorDetail string) {
+	s.Handle(method, path, code, errorBody(code, errorType, errorDetail))
+}
+
+// SetRateLimit sets the X-RateLimit-Limit and X-RateLimit-Remaining headers.
+// Each request uses one of the remaining requests and once there are none
+// left the Server answers with a 429 rate_limit_exceeded error.
+func (s *Server) SetRateLimit(limit, remaining int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.limit = limit
+	s.remaining = remaining
+}
+
+// Requests returns every request received so far, oldest first.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+// Reset forgets the recorded requests.
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests = nil
+}
+
+func (s *Server) addRoute(r route) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i, existing := range s.routes {
+		if existing.method == r.method && strings.Join(existing.segments, "/") == strings.Join(r.segments, "/") {
+			s.routes[i] = r
+			return
+		}
+	}
+	s.routes = append(s.routes, r)
+}
+
+func (s *Server) serve(w http.ResponseWriter, r *http.Request) {
+	body, _ := ioutil.ReadAll(r.Body)
+	r.Body.Close()
+	query := r.URL.Query()
+
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{
+		Method: r.Method,
+		Path:   r.URL.Path,
+		Query:  query,
+		Header: r.Header,
+		Body:   body,
+	})
+	limit := s.limit
+	exhausted := limit > 0 && s.remaining <= 0
+	if !exhausted {
+		s.remaining--
+	}
+	remaining := s.remaining
+	rt, found := s.match(r.Method, r.URL.Path)
+	s.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	if limit > 0 {
+		w.Header().Set("X-RateLimit-Limit", strconv.Itoa(limit))
+		w.Header().Set("X-RateLimit-Remaining", strconv.Itoa(remaining))
+		w.Header().Set("X-RateLimit-Path", r.URL.Path)
+	}
+
+	switch {
+	case exhausted:
+		writeError(w, http.StatusTooManyRequests, "rate_limit_exceeded", "Quota exceeded")
+		return
+	case query.Get("v") == "":
+		writeError(w, http.StatusBadRequest, "param_error", "Missing required parameter v")
+		return
+	case query.Get("client_id") == "":
+		writeError(w, http.StatusUnauthorized, "invalid_auth", "Missing access credentials.")
+		return
+	case query.Get("client_secret") == "" && query.Get("oauth_token") == "" && query.Get("access_token") == "":
+		writeError(w, http.StatusUnauthorized, "invalid_auth", "Missing access credentials.")
+		return
+	case !found:
+		writeError(w, http.StatusNotFound, "endpoint_error", fmt.Sprintf("Endpoint not found: %s %s", r.Method, r.URL.Path))
+		return
+	}
+
+	b := rt.body
+	if rt.file != "" {
+		var err error
+		if b, err = ioutil.ReadFile(rt.file); err != nil {
+			writeError(w, http.StatusInternalServerError, "server_error", err.Error())
+			return
+		}
+	}
+	w.WriteHeader(rt.status)
+	w.Write(b)
+}
+
+// match finds the route for the path preferring the one with the most
+// literal segments. Must be called with s.mu held.
+func (s *Server) match(method, path string) (route, bool) {
+	segments := split(path)
+	best, bestLiterals := route{}, -1
+	for _, r := range s.routes {
+		if r.method != method || len(r.segments) != len(segments) {
+			continue
+		}
+		literals := 0
+		ok := true
+		for i, seg := range r.segments {
+			if seg == "*" {
+				continue
+			}
+			if seg != segments[i] {
+				ok = false
+				break
+			}
+			literals++
+		}
+		if ok && literals > bestLiterals {
+			best, bestLiterals = r, literals
+		}
+	}
+	return best, bestLiterals >= 0
+}
+
+func split(path string) []string {
+	return strings.Split(strings.Trim(path, "/"), "/")
+}
+
+func errorBody(code int, errorType, errorDetail string) []byte {
+	b, _ := json.Marshal(map[string]interface{}{
+		"meta": map[string]interface{}{
+			"code":        code,
+			"errorType":   errorType,
+			"errorDetail": errorDetail,
+			"requestId":   "foursquaretest",
+		},
+		"response": map[string]interface{}{},
+	})
+	return b
+}
+
+func writeError(w http.ResponseWriter, code int, errorType, errorDetail string) {
+	w.WriteHeader(code)
+	w.Write(errorBody(code, errorType, errorDetail))
+}
@@ -0,0 +1,87 @@
+package foursquaretest_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/peppage/foursquarego"
+	"github.com/peppage/foursquarego/foursquaretest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServer_Fixtures(t *testing.T) {
+	server := foursquaretest.NewServer("../json")
+	defer server.Close()
+
+	client := foursquarego.NewClient(server.Client(), "foursquare", "ci", "cs", "")
+	venue, _, err := client.Venues.Details("5414d0a6498ea3d31a3c64cf")
+	assert.Nil(t, err)
+	assert.Equal(t, "Threes Brewing", venue.Name)
+
+	tips, _, err := client.Venues.Tips(&foursquarego.VenueTipsParams{VenueID: "5557c94e498ebde0672e57f4", Limit: 1})
+	assert.Nil(t, err)
+	assert.Len(t, tips, 1)
+
+	requests := server.Requests()
+	assert.Len(t, requests, 2)
+	assert.Equal(t, "GET", requests[1].Method)
+	assert.Equal(t, "/v2/venues/5557c94e498ebde0672e57f4/tips", requests[1].Path)
+	assert.Equal(t, "1", requests[1].Query.Get("limit"))
+	assert.Equal(t, "ci", requests[1].Query.Get("client_id"))
+}
+
+func TestServer_Handle(t *testing.T) {
+	server := foursquaretest.NewServer("../json")
+	defer server.Close()
+
+	server.Handle("GET", "/v2/venues/search", http.StatusOK, []byte(`{"meta":{"code":200},"response":{"venues":[{"id":"abc"}]}}`))
+	server.HandleError("GET", "/v2/venues/*/menu", 404, "param_error", "Value nope is invalid for venue id")
+
+	client := foursquarego.NewClient(server.Client(), "foursquare", "ci", "cs", "")
+	venues, _, err := client.Venues.Search(&foursquarego.VenueSearchParams{Near: "Chicago"})
+	assert.Nil(t, err)
+	assert.Equal(t, "abc", venues[0].ID)
+
+	_, _, err = client.Venues.Menu("nope")
+	if assert.IsType(t, &foursquarego.APIError{}, err) {
+		meta := err.(*foursquarego.APIError).Meta
+		assert.Equal(t, 404, meta.Code)
+		assert.Equal(t, "param_error", meta.ErrorType)
+	}
+}
+
+func TestServer_Auth(t *testing.T) {
+	server := foursquaretest.NewServer("../json")
+	defer server.Close()
+
+	client := foursquarego.NewClient(server.Client(), "foursquare", "ci", "", "")
+	_, resp, err := client.Venues.Details("5414d0a6498ea3d31a3c64cf")
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	if assert.IsType(t, &foursquarego.APIError{}, err) {
+		assert.Equal(t, "invalid_auth", err.(*foursquarego.APIError).Meta.ErrorType)
+	}
+
+	client = foursquarego.NewClient(server.Client(), "swarm", "ci", "", "token")
+	_, _, err = client.Venues.Details("5414d0a6498ea3d31a3c64cf")
+	assert.Nil(t, err)
+}
+
+func TestServer_RateLimit(t *testing.T) {
+	server := foursquaretest.NewServer("../json")
+	defer server.Close()
+	server.SetRateLimit(500, 1)
+
+	client := foursquarego.NewClient(server.Client(), "foursquare", "ci", "cs", "")
+	_, resp, err := client.Venues.Categories()
+	assert.Nil(t, err)
+	rl := foursquarego.ParseRate(resp)
+	assert.Equal(t, 500, rl.Limit)
+	assert.Equal(t, 0, rl.Remaining)
+	assert.Equal(t, "/v2/venues/categories", rl.Path)
+
+	_, resp, err = client.Venues.Categories()
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	if assert.IsType(t, &foursquarego.APIError{}, err) {
+		assert.Equal(t, "rate_limit_exceeded", err.(*foursquarego.APIError).Meta.ErrorType)
+	}
+}
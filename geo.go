@@ -0,0 +1,165 @@
+package foursquarego
+
+import (
+	"math"
+	"sort"
+	"strconv"
+)
+
+const earthRadius = 6371000 // meters
+
+// Positioner is anything with a position on the map. Venue, MiniVenue,
+// Location and LatLong are all Positioners.
+type Positioner interface {
+	Position() LatLong
+}
+
+// Position returns the LatLong itself.
+func (l LatLong) Position() LatLong {
+	return l
+}
+
+// String formats the LatLong as "lat,lng" which is how the LatLong, Sw and
+// Ne parameters are sent.
+func (l LatLong) String() string {
+	return strconv.FormatFloat(l.Lat, 'f', -1, 64) + "," + strconv.FormatFloat(l.Lng, 'f', -1, 64)
+}
+
+// Position returns the Lat and Lng of the Location.
+func (l Location) Position() LatLong {
+	return LatLong{Lat: l.Lat, Lng: l.Lng}
+}
+
+// Position returns the Lat and Lng of the Venue's Location.
+func (v Venue) Position() LatLong {
+	return v.Location.Position()
+}
+
+// Position returns the Lat and Lng of the MiniVenue's Location.
+func (v MiniVenue) Position() LatLong {
+	return v.Location.Position()
+}
+
+// Distance returns the great-circle distance in meters between a and b.
+// Unlike Location.Distance it doesn't need the API to have computed it.
+func Distance(a, b Positioner) float64 {
+	p, q := a.Position(), b.Position()
+	return haversine(p.Lat, p.Lng, q.Lat, q.Lng)
+}
+
+// Bearing returns the initial compass bearing in degrees, from 0 up to
+// 360, for travelling from a to b.
+func Bearing(a, b Positioner) float64 {
+	p, q := a.Position(), b.Position()
+	lat1, lat2 := radians(p.Lat), radians(q.Lat)
+	dLng := radians(q.Lng - p.Lng)
+
+	y := math.Sin(dLng) * math.Cos(lat2)
+	x := math.Cos(lat1)*math.Sin(lat2) - math.Sin(lat1)*math.Cos(lat2)*math.Cos(dLng)
+	return math.Mod(math.Atan2(y, x)*180/math.Pi+360, 360)
+}
+
+// BoundsAround returns the bounds of a square that holds the circle of
+// radius meters around center. Use the Sw and Ne with their String method
+// for the sw and ne parameters of VenueSearchParams. A circle reaching a
+// pole covers every longitude, from -180 to 180.
+func BoundsAround(center Positioner, radius float64) SuggestedBounds {
+	c := center.Position()
+	dLat := radius / earthRadius * 180 / math.Pi
+	b := SuggestedBounds{
+		Sw: LatLong{Lat: math.Max(c.Lat-dLat, -90), Lng: -180},
+		Ne: LatLong{Lat: math.Min(c.Lat+dLat, 90), Lng: 180},
+	}
+	if b.Sw.Lat <= -90 || b.Ne.Lat >= 90 {
+		return b
+	}
+
+	// The widest point of the circle is where a meridian touches it, not
+	// on the center's latitude.
+	x := math.Sin(radius/earthRadius) / math.Cos(radians(c.Lat))
+	if x < 1 {
+		dLng := math.Asin(x) * 180 / math.Pi
+		b.Sw.Lng = wrapLng(c.Lng - dLng)
+		b.Ne.Lng = wrapLng(c.Lng + dLng)
+	}
+	return b
+}
+
+// Contains is true when p is inside the bounds. Bounds that cross the
+// antimeridian have an Sw.Lng greater than their Ne.Lng.
+func (b SuggestedBounds) Contains(p Positioner) bool {
+	l := p.Position()
+	if l.Lat < b.Sw.Lat || l.Lat > b.Ne.Lat {
+		return false
+	}
+	if b.Sw.Lng <= b.Ne.Lng {
+		return l.Lng >= b.Sw.Lng && l.Lng <= b.Ne.Lng
+	}
+	return l.Lng >= b.Sw.Lng || l.Lng <= b.Ne.Lng
+}
+
+// Center returns the middle of the bounds.
+func (b SuggestedBounds) Center() LatLong {
+	return LatLong{
+		Lat: (b.Sw.Lat + b.Ne.Lat) / 2,
+		Lng: wrapLng(b.Sw.Lng + b.lngSpan()/2),
+	}
+}
+
+// lngSpan is the width of the bounds in degrees of longitude, going east
+// across the antimeridian when Sw.Lng is greater than Ne.Lng.
+func (b SuggestedBounds) lngSpan() float64 {
+	ne := b.Ne.Lng
+	if b.Sw.Lng > ne {
+		ne += 360
+	}
+	return ne - b.Sw.Lng
+}
+
+// SortVenuesByDistance sorts venues nearest first from the given point.
+func SortVenuesByDistance(venues []Venue, from Positioner) {
+	sort.SliceStable(venues, func(i, j int) bool {
+		return Distance(from, venues[i]) < Distance(from, venues[j])
+	})
+}
+
+// SortMiniVenuesByDistance sorts mini venues nearest first from the given
+// point.
+func SortMiniVenuesByDistance(venues []MiniVenue, from Positioner) {
+	sort.SliceStable(venues, func(i, j int) bool {
+		return Distance(from, venues[i]) < Distance(from, venues[j])
+	})
+}
+
+// SortRecommendsByDistance sorts the items of an Explore Recommendation
+// nearest first from the given point.
+func SortRecommendsByDistance(items []Recommend, from Positioner) {
+	sort.SliceStable(items, func(i, j int) bool {
+		return Distance(from, items[i].Venue) < Distance(from, items[j].Venue)
+	})
+}
+
+// haversine is the distance in meters between two points.
+func haversine(lat1, lng1, lat2, lng2 float64) float64 {
+	dLat := radians(lat2 - lat1)
+	dLng := radians(lng2 - lng1)
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(radians(lat1))*math.Cos(radians(lat2))*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * earthRadius * math.Asin(math.Sqrt(a))
+}
+
+func radians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
+
+// wrapLng keeps a longitude between -180 and 180.
+func wrapLng(lng float64) float64 {
+	if lng >= -180 && lng <= 180 {
+		return lng
+	}
+	lng = math.Mod(lng+180, 360)
+	if lng < 0 {
+		lng += 360
+	}
+	return lng - 180
+}
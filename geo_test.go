@@ -0,0 +1,128 @@
+package foursquarego
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	threes    = LatLong{Lat: 40.67979901271337, Lng: -73.98215935484912}
+	singlecut = Location{Lat: 40.778386547058325, Lng: -73.9019024216154}
+)
+
+func TestDistance(t *testing.T) {
+	assert.InDelta(t, 12880, Distance(threes, singlecut), 1)
+	assert.Equal(t, 0.0, Distance(threes, threes))
+	assert.Equal(t, Distance(threes, singlecut), Distance(Venue{Location: singlecut}, threes))
+}
+
+func TestBearing(t *testing.T) {
+	assert.InDelta(t, 0, Bearing(LatLong{0, 0}, LatLong{1, 0}), 0.0001)
+	assert.InDelta(t, 90, Bearing(LatLong{0, 0}, LatLong{0, 1}), 0.0001)
+	assert.InDelta(t, 270, Bearing(LatLong{0, 0}, LatLong{0, -1}), 0.0001)
+	assert.InDelta(t, 32, Bearing(threes, singlecut), 1)
+}
+
+func TestBoundsAround(t *testing.T) {
+	b := BoundsAround(threes, 1000)
+	assert.True(t, b.Contains(threes))
+	assert.False(t, b.Contains(singlecut))
+	assert.InDelta(t, 1000, Distance(threes, LatLong{Lat: b.Ne.Lat, Lng: threes.Lng}), 1)
+	assert.InDelta(t, 1000, Distance(threes, LatLong{Lat: threes.Lat, Lng: b.Sw.Lng}), 1)
+	assert.InDelta(t, threes.Lat, b.Center().Lat, 0.000001)
+	assert.InDelta(t, threes.Lng, b.Center().Lng, 0.000001)
+}
+
+func TestSuggestedBounds_ContainsAntimeridian(t *testing.T) {
+	b := BoundsAround(LatLong{Lat: 0, Lng: 180}, 10000)
+	assert.True(t, b.Sw.Lng > b.Ne.Lng)
+	assert.True(t, b.Contains(LatLong{Lat: 0, Lng: -179.99}))
+	assert.True(t, b.Contains(LatLong{Lat: 0, Lng: 179.99}))
+	assert.False(t, b.Contains(LatLong{Lat: 0, Lng: 0}))
+	assert.InDelta(t, 180, b.Center().Lng, 0.000001)
+}
+
+func TestBoundsAround_Poles(t *testing.T) {
+	b := BoundsAround(LatLong{Lat: 90}, 1000)
+	assert.Equal(t, -180.0, b.Sw.Lng)
+	assert.Equal(t, 180.0, b.Ne.Lng)
+	assert.Equal(t, 90.0, b.Ne.Lat)
+	assert.InDelta(t, 89.991, b.Sw.Lat, 0.001)
+
+	b = BoundsAround(LatLong{Lat: -89.9999999, Lng: 10}, 1000)
+	assert.Equal(t, -180.0, b.Sw.Lng)
+	assert.Equal(t, 180.0, b.Ne.Lng)
+	assert.Equal(t, -90.0, b.Sw.Lat)
+
+	// Near the pole the longitude span used to be huge enough for wrapLng
+	// to loop for a very long time.
+	b = BoundsAround(LatLong{Lat: 89.99, Lng: 10}, 10000)
+	assert.Equal(t, -180.0, b.Sw.Lng)
+	assert.Equal(t, 180.0, b.Ne.Lng)
+
+	b = BoundsAround(LatLong{Lat: 89.99, Lng: 10}, 1000)
+	assert.InDelta(t, -54.06, b.Sw.Lng, 0.01)
+	assert.InDelta(t, 74.06, b.Ne.Lng, 0.01)
+	assert.True(t, b.Ne.Lat < 90)
+}
+
+func TestBoundsAround_HoldsCircle(t *testing.T) {
+	for _, c := range []LatLong{threes, {Lat: 70, Lng: 20}, {Lat: -85, Lng: 179.5}, {Lat: 89.99, Lng: 10}} {
+		for _, radius := range []float64{1000, 50000} {
+			b := BoundsAround(c, radius)
+			for bearing := 0.0; bearing < 360; bearing += 1 {
+				// A millimeter inside so rounding doesn't put the edge out.
+				p := destination(c, bearing, radius-0.001)
+				assert.True(t, b.Contains(p), "%v %v %v", c, radius, bearing)
+			}
+		}
+	}
+}
+
+// destination is the point radius meters from c along bearing.
+func destination(c LatLong, bearing, radius float64) LatLong {
+	d := radius / earthRadius
+	lat1, lng1, brng := radians(c.Lat), radians(c.Lng), radians(bearing)
+	lat2 := math.Asin(math.Sin(lat1)*math.Cos(d) + math.Cos(lat1)*math.Sin(d)*math.Cos(brng))
+	lng2 := lng1 + math.Atan2(math.Sin(brng)*math.Sin(d)*math.Cos(lat1), math.Cos(d)-math.Sin(lat1)*math.Sin(lat2))
+	return LatLong{Lat: lat2 * 180 / math.Pi, Lng: wrapLng(lng2 * 180 / math.Pi)}
+}
+
+func TestWrapLng(t *testing.T) {
+	assert.Equal(t, 180.0, wrapLng(180))
+	assert.Equal(t, -179.0, wrapLng(181))
+	assert.Equal(t, 179.0, wrapLng(-181))
+	assert.InDelta(t, 10, wrapLng(3610), 0.000001)
+	assert.InDelta(t, -10, wrapLng(-1e6*360-10), 0.000001)
+}
+
+func TestLatLong_String(t *testing.T) {
+	assert.Equal(t, "40.7,-74", LatLong{Lat: 40.7, Lng: -74}.String())
+	assert.Equal(t, "0.00001,0", LatLong{Lat: 0.00001}.String())
+}
+
+func TestSortVenuesByDistance(t *testing.T) {
+	venues := []Venue{
+		{ID: "far", Location: singlecut},
+		{ID: "near", Location: Location{Lat: threes.Lat, Lng: threes.Lng}},
+	}
+	SortVenuesByDistance(venues, threes)
+	assert.Equal(t, "near", venues[0].ID)
+	assert.Equal(t, "far", venues[1].ID)
+
+	mini := []MiniVenue{
+		{ID: "far", Location: singlecut},
+		{ID: "near", Location: Location{Lat: threes.Lat, Lng: threes.Lng}},
+	}
+	SortMiniVenuesByDistance(mini, threes)
+	assert.Equal(t, "near", mini[0].ID)
+
+	items := []Recommend{
+		{Venue: venues[1]},
+		{Venue: venues[0]},
+	}
+	SortRecommendsByDistance(items, threes)
+	assert.Equal(t, "near", items[0].Venue.ID)
+}
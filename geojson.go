@@ -0,0 +1,329 @@
+package foursquarego
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// FeatureCollection is a GeoJSON FeatureCollection, which QGIS, Mapbox and
+// most other mapping tools can load. Marshal it with encoding/json.
+// https://tools.ietf.org/html/rfc7946
+type FeatureCollection struct {
+	Type     string    `json:"type"`
+	BBox     []float64 `json:"bbox,omitempty"`
+	Features []Feature `json:"features"`
+}
+
+// Feature is a GeoJSON Feature.
+type Feature struct {
+	Type       string                 `json:"type"`
+	ID         string                 `json:"id,omitempty"`
+	BBox       []float64              `json:"bbox,omitempty"`
+	Geometry   Geometry               `json:"geometry"`
+	Properties map[string]interface{} `json:"properties"`
+}
+
+// UnmarshalJSON reads a Feature whose id may be a string or a number, as
+// GeoJSON allows either. Numbers are kept as written in ID.
+func (f *Feature) UnmarshalJSON(b []byte) error {
+	type feature Feature
+	aux := struct {
+		*feature
+		ID json.RawMessage `json:"id"`
+	}{feature: (*feature)(f)}
+	if err := json.Unmarshal(b, &aux); err != nil {
+		return err
+	}
+
+	f.ID = ""
+	if len(aux.ID) == 0 || string(aux.ID) == "null" {
+		return nil
+	}
+	if err := json.Unmarshal(aux.ID, &f.ID); err == nil {
+		return nil
+	}
+	var n json.Number
+	if err := json.Unmarshal(aux.ID, &n); err != nil {
+		return fmt.Errorf("foursquarego: geojson feature id %s is not a string or number", aux.ID)
+	}
+	f.ID = n.String()
+	return nil
+}
+
+// Geometry is a GeoJSON Geometry. Coordinates are kept as json since
+// their shape depends on Type.
+type Geometry struct {
+	Type        string          `json:"type"`
+	Coordinates json.RawMessage `json:"coordinates"`
+}
+
+// Point returns the position of a Point geometry.
+func (g Geometry) Point() (LatLong, error) {
+	var c []float64
+	if g.Type != "Point" {
+		return LatLong{}, fmt.Errorf("foursquarego: geometry is a %s not a Point", g.Type)
+	}
+	if err := json.Unmarshal(g.Coordinates, &c); err != nil {
+		return LatLong{}, err
+	}
+	if len(c) < 2 {
+		return LatLong{}, fmt.Errorf("foursquarego: point has %d coordinates", len(c))
+	}
+	return LatLong{Lat: c[1], Lng: c[0]}, nil
+}
+
+// VenueProperty is a Venue field written to the properties of a Feature.
+// Set reads it back in DecodeGeoJSON, it is nil when that isn't possible.
+type VenueProperty struct {
+	Name string
+	Get  func(v Venue) interface{}
+	Set  func(v *Venue, value interface{})
+}
+
+// Properties for ToGeoJSON. Make a VenueProperty for any other field.
+var (
+	PropertyID = VenueProperty{
+		Name: "id",
+		Get:  func(v Venue) interface{} { return v.ID },
+		Set:  func(v *Venue, value interface{}) { v.ID = propertyString(value) },
+	}
+	PropertyName = VenueProperty{
+		Name: "name",
+		Get:  func(v Venue) interface{} { return v.Name },
+		Set:  func(v *Venue, value interface{}) { v.Name = propertyString(value) },
+	}
+	PropertyAddress = VenueProperty{
+		Name: "address",
+		Get:  func(v Venue) interface{} { return strings.Join(v.Location.FormattedAddress, ", ") },
+		Set: func(v *Venue, value interface{}) {
+			if s := propertyString(value); s != "" {
+				v.Location.FormattedAddress = []string{s}
+			}
+		},
+	}
+	PropertyCategory = VenueProperty{
+		Name: "category",
+		Get: func(v Venue) interface{} {
+			if c := v.PrimaryCategory(); c != nil {
+				return c.Name
+			}
+			return nil
+		},
+		Set: func(v *Venue, value interface{}) { primaryCategoryStub(v).Name = propertyString(value) },
+	}
+	PropertyCategoryID = VenueProperty{
+		Name: "categoryId",
+		Get: func(v Venue) interface{} {
+			if c := v.PrimaryCategory(); c != nil {
+				return c.ID
+			}
+			return nil
+		},
+		Set: func(v *Venue, value interface{}) { primaryCategoryStub(v).ID = propertyString(value) },
+	}
+	PropertyRating = VenueProperty{
+		Name: "rating",
+		Get:  func(v Venue) interface{} { return v.Rating },
+		Set:  func(v *Venue, value interface{}) { v.Rating = propertyFloat(value) },
+	}
+	PropertyPrice = VenueProperty{
+		Name: "price",
+		Get:  func(v Venue) interface{} { return v.Price.Tier },
+		Set:  func(v *Venue, value interface{}) { v.Price.Tier = int(propertyFloat(value)) },
+	}
+	PropertyCheckins = VenueProperty{
+		Name: "checkins",
+		Get:  func(v Venue) interface{} { return v.Stats.CheckinsCount },
+		Set:  func(v *Venue, value interface{}) { v.Stats.CheckinsCount = int(propertyFloat(value)) },
+	}
+	PropertyPhone = VenueProperty{
+		Name: "phone",
+		Get:  func(v Venue) interface{} { return v.Contact.FormattedPhone },
+		Set:  func(v *Venue, value interface{}) { v.Contact.FormattedPhone = propertyString(value) },
+	}
+	PropertyURL = VenueProperty{
+		Name: "url",
+		Get:  func(v Venue) interface{} { return v.URL },
+		Set:  func(v *Venue, value interface{}) { v.URL = propertyString(value) },
+	}
+	PropertyDistance = VenueProperty{
+		Name: "distance",
+		Get:  func(v Venue) interface{} { return v.Location.Distance },
+		Set:  func(v *Venue, value interface{}) { v.Location.Distance = int(propertyFloat(value)) },
+	}
+)
+
+// DefaultVenueProperties are used when no properties are given.
+var DefaultVenueProperties = []VenueProperty{
+	PropertyID,
+	PropertyName,
+	PropertyAddress,
+	PropertyCategory,
+}
+
+// VenuesToGeoJSON returns a Point Feature for each venue with the given
+// properties, or DefaultVenueProperties when there are none.
+func VenuesToGeoJSON(venues []Venue, props ...VenueProperty) *FeatureCollection {
+	fc := newFeatureCollection(len(venues))
+	for _, v := range venues {
+		fc.Features = append(fc.Features, venueFeature(v, props))
+	}
+	return fc
+}
+
+// MiniVenuesToGeoJSON is VenuesToGeoJSON for the results of
+// SuggestCompletion. Only the id, name, location and categories of a
+// MiniVenue are known, other properties get their zero value.
+func MiniVenuesToGeoJSON(venues []MiniVenue, props ...VenueProperty) *FeatureCollection {
+	fc := newFeatureCollection(len(venues))
+	for _, m := range venues {
+		v := Venue{ID: m.ID, Name: m.Name, Location: m.Location, Categories: m.Category}
+		fc.Features = append(fc.Features, venueFeature(v, props))
+	}
+	return fc
+}
+
+// ToGeoJSON returns the venues of every group of an Explore response in
+// one FeatureCollection. Each Feature has a "group" property with the name
+// of its group and the collection's bbox is the SuggestedBounds.
+func (r *VenueExploreResp) ToGeoJSON(props ...VenueProperty) *FeatureCollection {
+	fc := newFeatureCollection(0)
+	if r.SuggestedBounds != (SuggestedBounds{}) {
+		fc.BBox = r.SuggestedBounds.bbox()
+	}
+	for _, g := range r.Groups {
+		for _, item := range g.Items {
+			f := venueFeature(item.Venue, props)
+			f.Properties["group"] = g.Name
+			fc.Features = append(fc.Features, f)
+		}
+	}
+	return fc
+}
+
+// ToGeoJSON returns the bounds as a Polygon Feature. Bounds that cross the
+// antimeridian are split into a MultiPolygon as RFC 7946 asks.
+func (b SuggestedBounds) ToGeoJSON() Feature {
+	ring := func(west, east float64) [][2]float64 {
+		return [][2]float64{
+			{west, b.Sw.Lat},
+			{east, b.Sw.Lat},
+			{east, b.Ne.Lat},
+			{west, b.Ne.Lat},
+			{west, b.Sw.Lat},
+		}
+	}
+
+	var g Geometry
+	if b.Sw.Lng <= b.Ne.Lng {
+		g = newGeometry("Polygon", [][][2]float64{ring(b.Sw.Lng, b.Ne.Lng)})
+	} else {
+		g = newGeometry("MultiPolygon", [][][][2]float64{
+			{ring(b.Sw.Lng, 180)},
+			{ring(-180, b.Ne.Lng)},
+		})
+	}
+
+	return Feature{
+		Type:       "Feature",
+		BBox:       b.bbox(),
+		Geometry:   g,
+		Properties: map[string]interface{}{},
+	}
+}
+
+// DecodeGeoJSON reads a FeatureCollection back into Venue stubs. The
+// properties are read with the Set of the given properties, or of
+// DefaultVenueProperties when there are none, and features that aren't
+// Points are skipped.
+func DecodeGeoJSON(r io.Reader, props ...VenueProperty) ([]Venue, error) {
+	var fc FeatureCollection
+	if err := json.NewDecoder(r).Decode(&fc); err != nil {
+		return nil, err
+	}
+	if fc.Type != "FeatureCollection" {
+		return nil, fmt.Errorf("foursquarego: geojson is a %s not a FeatureCollection", fc.Type)
+	}
+	if len(props) == 0 {
+		props = DefaultVenueProperties
+	}
+
+	var venues []Venue
+	for _, f := range fc.Features {
+		if f.Geometry.Type != "Point" {
+			continue
+		}
+		p, err := f.Geometry.Point()
+		if err != nil {
+			return nil, err
+		}
+
+		v := Venue{ID: f.ID, Location: Location{Lat: p.Lat, Lng: p.Lng}}
+		for _, prop := range props {
+			if value, ok := f.Properties[prop.Name]; ok && value != nil && prop.Set != nil {
+				prop.Set(&v, value)
+			}
+		}
+		venues = append(venues, v)
+	}
+	return venues, nil
+}
+
+func newFeatureCollection(size int) *FeatureCollection {
+	return &FeatureCollection{
+		Type:     "FeatureCollection",
+		Features: make([]Feature, 0, size),
+	}
+}
+
+func venueFeature(v Venue, props []VenueProperty) Feature {
+	if len(props) == 0 {
+		props = DefaultVenueProperties
+	}
+
+	properties := make(map[string]interface{}, len(props))
+	for _, p := range props {
+		properties[p.Name] = p.Get(v)
+	}
+
+	return Feature{
+		Type:       "Feature",
+		ID:         v.ID,
+		Geometry:   newGeometry("Point", [2]float64{v.Location.Lng, v.Location.Lat}),
+		Properties: properties,
+	}
+}
+
+func newGeometry(kind string, coordinates interface{}) Geometry {
+	b, _ := json.Marshal(coordinates)
+	return Geometry{Type: kind, Coordinates: b}
+}
+
+// bbox is the GeoJSON bounding box, west, south, east then north.
+func (b SuggestedBounds) bbox() []float64 {
+	return []float64{b.Sw.Lng, b.Sw.Lat, b.Ne.Lng, b.Ne.Lat}
+}
+
+// primaryCategoryStub returns the primary category of v, adding one when
+// it has none.
+func primaryCategoryStub(v *Venue) *Category {
+	if c := v.PrimaryCategory(); c != nil {
+		return c
+	}
+	v.Categories = append(v.Categories, Category{Primary: true})
+	return &v.Categories[0]
+}
+
+func propertyString(value interface{}) string {
+	if s, ok := value.(string); ok {
+		return s
+	}
+	return fmt.Sprint(value)
+}
+
+func propertyFloat(value interface{}) float64 {
+	f, _ := value.(float64)
+	return f
+}
@@ -0,0 +1,146 @@
+package foursquarego
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testExploreResp(t *testing.T) *VenueExploreResp {
+	b, err := getTestFile("./json/venues/explore.json")
+	assert.Nil(t, err)
+
+	var r Response
+	assert.Nil(t, json.Unmarshal(b, &r))
+	explore := new(VenueExploreResp)
+	assert.Nil(t, json.Unmarshal(r.Response, explore))
+	return explore
+}
+
+func TestVenuesToGeoJSON(t *testing.T) {
+	venues := []Venue{{
+		ID:         "5414d0a6498ea3d31a3c64cf",
+		Name:       "Threes Brewing",
+		Location:   Location{Lat: threes.Lat, Lng: threes.Lng, FormattedAddress: []string{"333 Douglass St", "Brooklyn, NY 11217"}},
+		Categories: []Category{{ID: "4bf58dd8d48988d116941735", Name: "Bar"}, {ID: "50327c8591d4c4b30a586d5d", Name: "Brewery", Primary: true}},
+		Rating:     9.4,
+	}}
+
+	b, err := json.Marshal(VenuesToGeoJSON(venues))
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{
+		"type": "FeatureCollection",
+		"features": [{
+			"type": "Feature",
+			"id": "5414d0a6498ea3d31a3c64cf",
+			"geometry": {"type": "Point", "coordinates": [-73.98215935484912, 40.67979901271337]},
+			"properties": {
+				"id": "5414d0a6498ea3d31a3c64cf",
+				"name": "Threes Brewing",
+				"address": "333 Douglass St, Brooklyn, NY 11217",
+				"category": "Brewery"
+			}
+		}]
+	}`, string(b))
+
+	fc := VenuesToGeoJSON(venues, PropertyName, PropertyRating)
+	assert.Equal(t, map[string]interface{}{"name": "Threes Brewing", "rating": 9.4}, fc.Features[0].Properties)
+
+	b, err = json.Marshal(VenuesToGeoJSON(nil))
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"type": "FeatureCollection", "features": []}`, string(b))
+}
+
+func TestMiniVenuesToGeoJSON(t *testing.T) {
+	fc := MiniVenuesToGeoJSON([]MiniVenue{{
+		ID:       "5a187743ccad6b307315e6fe",
+		Name:     "Foursquare HQ",
+		Location: singlecut,
+		Category: []Category{{Name: "Office"}},
+	}})
+
+	assert.Len(t, fc.Features, 1)
+	p, err := fc.Features[0].Geometry.Point()
+	assert.Nil(t, err)
+	assert.Equal(t, singlecut.Position(), p)
+	assert.Equal(t, "Office", fc.Features[0].Properties["category"])
+}
+
+func TestVenueExploreResp_ToGeoJSON(t *testing.T) {
+	explore := testExploreResp(t)
+
+	fc := explore.ToGeoJSON(PropertyID, PropertyName)
+	assert.Equal(t, []float64{-73.97800190124289, 40.76303582435602, -73.98070944232728, 40.765735484141466}, fc.BBox)
+	assert.Len(t, fc.Features, 3)
+	assert.Equal(t, "3fd66200f964a520b6e71ee3", fc.Features[0].ID)
+	assert.Equal(t, map[string]interface{}{
+		"id":    "3fd66200f964a520b6e71ee3",
+		"name":  "Carnegie Hall",
+		"group": "recommended",
+	}, fc.Features[0].Properties)
+}
+
+func TestSuggestedBounds_ToGeoJSON(t *testing.T) {
+	b := SuggestedBounds{Sw: LatLong{Lat: 40, Lng: -74}, Ne: LatLong{Lat: 41, Lng: -73}}
+	f := b.ToGeoJSON()
+	assert.Equal(t, []float64{-74, 40, -73, 41}, f.BBox)
+	assert.Equal(t, "Polygon", f.Geometry.Type)
+	assert.JSONEq(t, `[[[-74,40],[-73,40],[-73,41],[-74,41],[-74,40]]]`, string(f.Geometry.Coordinates))
+
+	f = SuggestedBounds{Sw: LatLong{Lat: -1, Lng: 179}, Ne: LatLong{Lat: 1, Lng: -179}}.ToGeoJSON()
+	assert.Equal(t, "MultiPolygon", f.Geometry.Type)
+	assert.JSONEq(t, `[
+		[[[179,-1],[180,-1],[180,1],[179,1],[179,-1]]],
+		[[[-180,-1],[-179,-1],[-179,1],[-180,1],[-180,-1]]]
+	]`, string(f.Geometry.Coordinates))
+}
+
+func TestDecodeGeoJSON(t *testing.T) {
+	explore := testExploreResp(t)
+	props := []VenueProperty{PropertyID, PropertyName, PropertyAddress, PropertyCategory, PropertyCategoryID, PropertyPrice}
+
+	b, err := json.Marshal(explore.ToGeoJSON(props...))
+	assert.Nil(t, err)
+
+	venues, err := DecodeGeoJSON(bytes.NewReader(b), props...)
+	assert.Nil(t, err)
+	assert.Len(t, venues, 3)
+
+	original := explore.Groups[0].Items[0].Venue
+	assert.Equal(t, original.ID, venues[0].ID)
+	assert.Equal(t, original.Name, venues[0].Name)
+	assert.Equal(t, original.Location.Position(), venues[0].Position())
+	assert.Equal(t, []string{"881 7th Ave (at W 57th St), New York, NY 10019"}, venues[0].Location.FormattedAddress)
+	assert.Equal(t, original.Categories[0].ID, venues[0].PrimaryCategory().ID)
+	assert.Equal(t, "Concert Hall", venues[0].PrimaryCategory().Name)
+	assert.Len(t, venues[0].Categories, 1)
+
+	_, err = DecodeGeoJSON(bytes.NewReader([]byte(`{"type": "Feature"}`)))
+	assert.NotNil(t, err)
+
+	venues, err = DecodeGeoJSON(bytes.NewReader([]byte(`{"type": "FeatureCollection", "features": [
+		{"type": "Feature", "id": "a", "geometry": {"type": "Point", "coordinates": [1, 2]}, "properties": null},
+		{"type": "Feature", "geometry": {"type": "LineString", "coordinates": [[1, 2], [3, 4]]}, "properties": {}}
+	]}`)))
+	assert.Nil(t, err)
+	assert.Equal(t, []Venue{{ID: "a", Location: Location{Lat: 2, Lng: 1}}}, venues)
+
+	venues, err = DecodeGeoJSON(bytes.NewReader([]byte(`{"type": "FeatureCollection", "features": [
+		{"type": "Feature", "id": 7, "geometry": {"type": "Point", "coordinates": [1, 2]}, "properties": {"name": "Seven"}},
+		{"type": "Feature", "id": 12.5, "geometry": {"type": "Point", "coordinates": [3, 4]}, "properties": {}},
+		{"type": "Feature", "id": null, "geometry": {"type": "Point", "coordinates": [5, 6]}, "properties": {}}
+	]}`)))
+	assert.Nil(t, err)
+	assert.Len(t, venues, 3)
+	assert.Equal(t, "7", venues[0].ID)
+	assert.Equal(t, "Seven", venues[0].Name)
+	assert.Equal(t, "12.5", venues[1].ID)
+	assert.Equal(t, "", venues[2].ID)
+
+	_, err = DecodeGeoJSON(bytes.NewReader([]byte(`{"type": "FeatureCollection", "features": [
+		{"type": "Feature", "id": {}, "geometry": {"type": "Point", "coordinates": [1, 2]}}
+	]}`)))
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,53 @@
+package foursquarego
+
+import (
+	"encoding/xml"
+	"io"
+)
+
+type gpxDocument struct {
+	XMLName   xml.Name      `xml:"http://www.topografix.com/GPX/1/1 gpx"`
+	Version   string        `xml:"version,attr"`
+	Creator   string        `xml:"creator,attr"`
+	Name      string        `xml:"metadata>name,omitempty"`
+	Waypoints []gpxWaypoint `xml:"wpt"`
+}
+
+type gpxWaypoint struct {
+	Lat         string    `xml:"lat,attr"`
+	Lon         string    `xml:"lon,attr"`
+	Name        string    `xml:"name"`
+	Comment     string    `xml:"cmt,omitempty"`
+	Description string    `xml:"desc,omitempty"`
+	Links       []gpxLink `xml:"link"`
+	Type        string    `xml:"type,omitempty"`
+}
+
+type gpxLink struct {
+	Href string `xml:"href,attr"`
+	Text string `xml:"text,omitempty"`
+	Type string `xml:"type,omitempty"`
+}
+
+// WriteGPX writes the placemarks as GPX 1.1 waypoints for GPS devices. The
+// address is the waypoint's comment, the category its type and the
+// category icon is added as a link.
+func WriteGPX(w io.Writer, name string, placemarks []Placemark) error {
+	doc := gpxDocument{Version: "1.1", Creator: "foursquarego", Name: name}
+	for _, p := range placemarks {
+		wpt := gpxWaypoint{
+			Lat:         formatCoordinate(p.Position.Lat),
+			Lon:         formatCoordinate(p.Position.Lng),
+			Name:        p.Name,
+			Comment:     p.Address,
+			Description: p.Description,
+			Type:        p.Category,
+		}
+		if p.IconURL != "" {
+			wpt.Links = append(wpt.Links, gpxLink{Href: p.IconURL, Text: p.Category, Type: "image/png"})
+		}
+		doc.Waypoints = append(doc.Waypoints, wpt)
+	}
+
+	return writeXML(w, doc)
+}
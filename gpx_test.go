@@ -0,0 +1,32 @@
+package foursquarego
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteGPX(t *testing.T) {
+	placemarks := ListPlacemarks(testListDetails(t).ListItems)
+
+	var buf bytes.Buffer
+	assert.Nil(t, WriteGPX(&buf, "Breweries", placemarks[:1]))
+	assert.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>
+<gpx xmlns="http://www.topografix.com/GPX/1/1" version="1.1" creator="foursquarego">
+  <metadata>
+    <name>Breweries</name>
+  </metadata>
+  <wpt lat="40.67979901271337" lon="-73.98215935484912">
+    <name>Threes Brewing</name>
+    <cmt>333 Douglass St (at 4th Ave), Brooklyn, NY 11217</cmt>
+    <desc>Great outdoor space and the rotating kitchen is always worth a look.</desc>
+    <link href="https://ss3.4sqi.net/img/categories_v2/food/brewery_64.png">
+      <text>Brewery</text>
+      <type>image/png</type>
+    </link>
+    <type>Brewery</type>
+  </wpt>
+</gpx>
+`, buf.String())
+}
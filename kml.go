@@ -0,0 +1,153 @@
+package foursquarego
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// PlacemarkIconSize is the size of the category icons used by Placemark.
+const PlacemarkIconSize = 64
+
+// Placemark is a venue as WriteKML and WriteGPX show it.
+type Placemark struct {
+	ID          string
+	Name        string
+	Address     string
+	Description string
+	Category    string
+	IconURL     string
+	Position    LatLong
+}
+
+// VenuePlacemarks makes a Placemark for each venue. The description is the
+// first tip the venue came with, or its own description when there is none.
+func VenuePlacemarks(venues []Venue) []Placemark {
+	placemarks := make([]Placemark, len(venues))
+	for i, v := range venues {
+		description := v.Description
+		for _, g := range v.Tips.Groups {
+			if len(g.Items) > 0 {
+				description = g.Items[0].Text
+				break
+			}
+		}
+		placemarks[i] = venuePlacemark(v, description)
+	}
+	return placemarks
+}
+
+// ListPlacemarks makes a Placemark for each venue on a list with the tip
+// saved to the list as the description.
+func ListPlacemarks(items ListItems) []Placemark {
+	placemarks := make([]Placemark, len(items.Items))
+	for i, item := range items.Items {
+		placemarks[i] = venuePlacemark(item.Venue, item.Tip.Text)
+	}
+	return placemarks
+}
+
+func venuePlacemark(v Venue, description string) Placemark {
+	p := Placemark{
+		ID:          v.ID,
+		Name:        v.Name,
+		Address:     strings.Join(v.Location.FormattedAddress, ", "),
+		Description: description,
+		Position:    v.Position(),
+	}
+	if c := v.PrimaryCategory(); c != nil {
+		p.Category = c.Name
+		p.IconURL = c.Icon.URL(PlacemarkIconSize)
+	}
+	return p
+}
+
+type kmlDocument struct {
+	XMLName xml.Name    `xml:"http://www.opengis.net/kml/2.2 kml"`
+	Name    string      `xml:"Document>name,omitempty"`
+	Styles  []kmlStyle  `xml:"Document>Style"`
+	Folders []kmlFolder `xml:"Document>Folder"`
+}
+
+type kmlStyle struct {
+	ID   string `xml:"id,attr"`
+	Icon string `xml:"IconStyle>Icon>href"`
+}
+
+type kmlFolder struct {
+	Name       string         `xml:"name"`
+	Placemarks []kmlPlacemark `xml:"Placemark"`
+}
+
+type kmlPlacemark struct {
+	ID          string `xml:"id,attr,omitempty"`
+	Name        string `xml:"name"`
+	Address     string `xml:"address,omitempty"`
+	Description string `xml:"description,omitempty"`
+	StyleURL    string `xml:"styleUrl,omitempty"`
+	Coordinates string `xml:"Point>coordinates"`
+}
+
+// WriteKML writes the placemarks as a KML document for Google Earth. Each
+// primary category gets a Folder, in the order they first appear, and
+// placemarks without a category go in a folder named "Other". Category
+// icons are used as the placemark icons.
+func WriteKML(w io.Writer, name string, placemarks []Placemark) error {
+	doc := kmlDocument{Name: name}
+	folders := make(map[string]int)
+	styles := make(map[string]string)
+
+	for _, p := range placemarks {
+		category := p.Category
+		if category == "" {
+			category = "Other"
+		}
+		i, ok := folders[category]
+		if !ok {
+			i = len(doc.Folders)
+			folders[category] = i
+			doc.Folders = append(doc.Folders, kmlFolder{Name: category})
+		}
+
+		styleURL := ""
+		if p.IconURL != "" {
+			id, ok := styles[p.IconURL]
+			if !ok {
+				id = fmt.Sprintf("icon%d", len(doc.Styles))
+				styles[p.IconURL] = id
+				doc.Styles = append(doc.Styles, kmlStyle{ID: id, Icon: p.IconURL})
+			}
+			styleURL = "#" + id
+		}
+
+		doc.Folders[i].Placemarks = append(doc.Folders[i].Placemarks, kmlPlacemark{
+			ID:          p.ID,
+			Name:        p.Name,
+			Address:     p.Address,
+			Description: p.Description,
+			StyleURL:    styleURL,
+			Coordinates: formatCoordinate(p.Position.Lng) + "," + formatCoordinate(p.Position.Lat),
+		})
+	}
+
+	return writeXML(w, doc)
+}
+
+func writeXML(w io.Writer, v interface{}) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func formatCoordinate(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
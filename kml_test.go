@@ -0,0 +1,96 @@
+package foursquarego
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testListDetails(t *testing.T) List {
+	b, err := getTestFile("./json/lists/details.json")
+	assert.Nil(t, err)
+
+	var r Response
+	assert.Nil(t, json.Unmarshal(b, &r))
+	list := new(listResp)
+	assert.Nil(t, json.Unmarshal(r.Response, list))
+	return list.List
+}
+
+func TestListPlacemarks(t *testing.T) {
+	placemarks := ListPlacemarks(testListDetails(t).ListItems)
+	assert.Len(t, placemarks, 2)
+	assert.Equal(t, Placemark{
+		ID:          "5414d0a6498ea3d31a3c64cf",
+		Name:        "Threes Brewing",
+		Address:     "333 Douglass St (at 4th Ave), Brooklyn, NY 11217",
+		Description: "Great outdoor space and the rotating kitchen is always worth a look.",
+		Category:    "Brewery",
+		IconURL:     "https://ss3.4sqi.net/img/categories_v2/food/brewery_64.png",
+		Position:    LatLong{Lat: 40.67979901271337, Lng: -73.98215935484912},
+	}, placemarks[0])
+	assert.Equal(t, "", placemarks[1].Description)
+}
+
+func TestVenuePlacemarks(t *testing.T) {
+	placemarks := VenuePlacemarks([]Venue{
+		{Name: "A", Description: "About A"},
+		{Name: "B", Description: "About B", Tips: Tips{Groups: []TipGroup{{Items: []Tip{{Text: "Try the stout"}}}}}},
+	})
+	assert.Equal(t, "About A", placemarks[0].Description)
+	assert.Equal(t, "Try the stout", placemarks[1].Description)
+	assert.Equal(t, "", placemarks[0].IconURL)
+}
+
+func TestWriteKML(t *testing.T) {
+	placemarks := ListPlacemarks(testListDetails(t).ListItems)
+	placemarks = append(placemarks, Placemark{Name: "Park & Ride", Position: LatLong{Lat: 1, Lng: 2}})
+
+	var buf bytes.Buffer
+	assert.Nil(t, WriteKML(&buf, "Brooklyn & Queens Breweries", placemarks))
+	assert.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>
+<kml xmlns="http://www.opengis.net/kml/2.2">
+  <Document>
+    <name>Brooklyn &amp; Queens Breweries</name>
+    <Style id="icon0">
+      <IconStyle>
+        <Icon>
+          <href>https://ss3.4sqi.net/img/categories_v2/food/brewery_64.png</href>
+        </Icon>
+      </IconStyle>
+    </Style>
+    <Folder>
+      <name>Brewery</name>
+      <Placemark id="5414d0a6498ea3d31a3c64cf">
+        <name>Threes Brewing</name>
+        <address>333 Douglass St (at 4th Ave), Brooklyn, NY 11217</address>
+        <description>Great outdoor space and the rotating kitchen is always worth a look.</description>
+        <styleUrl>#icon0</styleUrl>
+        <Point>
+          <coordinates>-73.98215935484912,40.67979901271337</coordinates>
+        </Point>
+      </Placemark>
+      <Placemark id="4f68de6bd5fbee32e5f4f3a5">
+        <name>SingleCut Beersmiths</name>
+        <address>19-33 37th St (btwn 19th &amp; 20th Ave), Astoria, NY 11105</address>
+        <styleUrl>#icon0</styleUrl>
+        <Point>
+          <coordinates>-73.9019024216154,40.778386547058325</coordinates>
+        </Point>
+      </Placemark>
+    </Folder>
+    <Folder>
+      <name>Other</name>
+      <Placemark>
+        <name>Park &amp; Ride</name>
+        <Point>
+          <coordinates>2,1</coordinates>
+        </Point>
+      </Placemark>
+    </Folder>
+  </Document>
+</kml>
+`, buf.String())
+}
@@ -0,0 +1,318 @@
+package foursquarego
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/dghubble/sling"
+)
+
+// ListService provides a method for accessing Foursquare list endpoints
+type ListService struct {
+	sling *sling.Sling
+}
+
+func newListService(sling *sling.Sling) *ListService {
+	return &ListService{
+		sling: sling.Path("lists/"),
+	}
+}
+
+type listResp struct {
+	List List `json:"list"`
+}
+
+type listItemResp struct {
+	Item ListItem `json:"item"`
+}
+
+// ListDetailsParams are the parameters for ListService.Details
+type ListDetailsParams struct {
+	ListID string `url:"-"`
+	Limit  int    `url:"limit,omitempty"`
+	Offset int    `url:"offset,omitempty"`
+}
+
+// Details gets a list with its items. Use Limit and Offset to page
+// through the ListItems.
+// https://developer.foursquare.com/docs/api/lists/details
+func (s *ListService) Details(params *ListDetailsParams) (*List, *http.Response, error) {
+	list := new(listResp)
+	response := new(Response)
+
+	resp, err := s.sling.New().Get(params.ListID).QueryStruct(params).Receive(response, response)
+	if err == nil {
+		json.Unmarshal(response.Response, list)
+	}
+
+	return &list.List, resp, relevantError(err, *response)
+}
+
+// Items pages through all the items on a list. Each ListItem has the
+// Venue it is for.
+// https://developer.foursquare.com/docs/api/lists/details
+func (s *ListService) Items(id string) ([]ListItem, *http.Response, error) {
+	const pageSize = 200
+	params := &ListDetailsParams{ListID: id, Limit: pageSize}
+
+	var items []ListItem
+	for {
+		list, resp, err := s.Details(params)
+		if err != nil {
+			return items, resp, err
+		}
+
+		items = append(items, list.ListItems.Items...)
+		count := list.ListItems.Count
+		if len(list.ListItems.Items) < pageSize || count > 0 && len(items) >= count {
+			return items, resp, nil
+		}
+		params.Offset += pageSize
+	}
+}
+
+// ListAddParams are the parameters for ListService.Add
+type ListAddParams struct {
+	Name          string `url:"name"`
+	Description   string `url:"description,omitempty"`
+	Collaborative bool   `url:"collaborative,omitempty"`
+	PhotoID       string `url:"photoId,omitempty"`
+}
+
+// Add creates a new list for the acting user.
+// https://developer.foursquare.com/docs/api/lists/add
+func (s *ListService) Add(params *ListAddParams) (*List, *http.Response, error) {
+	list := new(listResp)
+	response := new(Response)
+
+	resp, err := s.sling.New().Post("add").BodyForm(params).Receive(response, response)
+	if err == nil {
+		json.Unmarshal(response.Response, list)
+	}
+
+	return &list.List, resp, relevantError(err, *response)
+}
+
+// ListUpdateParams are the parameters for ListService.Update. Only the
+// fields that are set are changed. Collaborative is a pointer so that it
+// can be turned off.
+type ListUpdateParams struct {
+	ListID        string `url:"-"`
+	Name          string `url:"name,omitempty"`
+	Description   string `url:"description,omitempty"`
+	Collaborative *bool  `url:"collaborative,omitempty"`
+	PhotoID       string `url:"photoId,omitempty"`
+}
+
+// Update changes a list the acting user owns.
+// https://developer.foursquare.com/docs/api/lists/update
+func (s *ListService) Update(params *ListUpdateParams) (*List, *http.Response, error) {
+	list := new(listResp)
+	response := new(Response)
+
+	resp, err := s.sling.New().Post(params.ListID+"/update").BodyForm(params).Receive(response, response)
+	if err == nil {
+		json.Unmarshal(response.Response, list)
+	}
+
+	return &list.List, resp, relevantError(err, *response)
+}
+
+// ListAddItemParams are the parameters for ListService.AddItem. Set either
+// VenueID, TipID or ItemID together with ListID of the list it is on.
+type ListAddItemParams struct {
+	ListID     string `url:"-"`
+	VenueID    string `url:"venueId,omitempty"`
+	Text       string `url:"text,omitempty"`
+	URL        string `url:"url,omitempty"`
+	TipID      string `url:"tipId,omitempty"`
+	FromListID string `url:"listId,omitempty"`
+	ItemID     string `url:"itemId,omitempty"`
+}
+
+// AddItem adds a venue, tip or another list's item to a list.
+// https://developer.foursquare.com/docs/api/lists/additem
+func (s *ListService) AddItem(params *ListAddItemParams) (*ListItem, *http.Response, error) {
+	item := new(listItemResp)
+	response := new(Response)
+
+	resp, err := s.sling.New().Post(params.ListID+"/additem").BodyForm(params).Receive(response, response)
+	if err == nil {
+		json.Unmarshal(response.Response, item)
+	}
+
+	return &item.Item, resp, relevantError(err, *response)
+}
+
+// ListDeleteItemParams are the parameters for ListService.DeleteItem. Set
+// one of ItemID, VenueID or TipID.
+type ListDeleteItemParams struct {
+	ListID  string `url:"-"`
+	ItemID  string `url:"itemId,omitempty"`
+	VenueID string `url:"venueId,omitempty"`
+	TipID   string `url:"tipId,omitempty"`
+}
+
+// DeleteItem removes an item from a list.
+// https://developer.foursquare.com/docs/api/lists/deleteitem
+func (s *ListService) DeleteItem(params *ListDeleteItemParams) (*ListItem, *http.Response, error) {
+	item := new(listItemResp)
+	response := new(Response)
+
+	resp, err := s.sling.New().Post(params.ListID+"/deleteitem").BodyForm(params).Receive(response, response)
+	if err == nil {
+		json.Unmarshal(response.Response, item)
+	}
+
+	return &item.Item, resp, relevantError(err, *response)
+}
+
+// ListUpdateItemParams are the parameters for ListService.UpdateItem
+type ListUpdateItemParams struct {
+	ListID  string `url:"-"`
+	ItemID  string `url:"itemId"`
+	TipID   string `url:"tipId,omitempty"`
+	Text    string `url:"text,omitempty"`
+	URL     string `url:"url,omitempty"`
+	PhotoID string `url:"photoId,omitempty"`
+}
+
+// UpdateItem changes the tip, text, url or photo of an item on a list.
+// https://developer.foursquare.com/docs/api/lists/updateitem
+func (s *ListService) UpdateItem(params *ListUpdateItemParams) (*ListItem, *http.Response, error) {
+	item := new(listItemResp)
+	response := new(Response)
+
+	resp, err := s.sling.New().Post(params.ListID+"/updateitem").BodyForm(params).Receive(response, response)
+	if err == nil {
+		json.Unmarshal(response.Response, item)
+	}
+
+	return &item.Item, resp, relevantError(err, *response)
+}
+
+// ListMoveItemParams are the parameters for ListService.MoveItem. Set
+// either BeforeID or AfterID.
+type ListMoveItemParams struct {
+	ListID   string `url:"-"`
+	ItemID   string `url:"itemId"`
+	BeforeID string `url:"beforeId,omitempty"`
+	AfterID  string `url:"afterId,omitempty"`
+}
+
+type listMoveItemResp struct {
+	ListItems ListItems `json:"listItems"`
+}
+
+// MoveItem moves an item to before or after another item on the list and
+// returns the items in their new order.
+// https://developer.foursquare.com/docs/api/lists/moveitem
+func (s *ListService) MoveItem(params *ListMoveItemParams) (*ListItems, *http.Response, error) {
+	items := new(listMoveItemResp)
+	response := new(Response)
+
+	resp, err := s.sling.New().Post(params.ListID+"/moveitem").BodyForm(params).Receive(response, response)
+	if err == nil {
+		json.Unmarshal(response.Response, items)
+	}
+
+	return &items.ListItems, resp, relevantError(err, *response)
+}
+
+// Follow makes the acting user follow a list.
+// https://developer.foursquare.com/docs/api/lists/follow
+func (s *ListService) Follow(id string) (*List, *http.Response, error) {
+	list := new(listResp)
+	response := new(Response)
+
+	resp, err := s.sling.New().Post(id+"/follow").Receive(response, response)
+	if err == nil {
+		json.Unmarshal(response.Response, list)
+	}
+
+	return &list.List, resp, relevantError(err, *response)
+}
+
+// Unfollow makes the acting user stop following a list.
+// https://developer.foursquare.com/docs/api/lists/unfollow
+func (s *ListService) Unfollow(id string) (*List, *http.Response, error) {
+	list := new(listResp)
+	response := new(Response)
+
+	resp, err := s.sling.New().Post(id+"/unfollow").Receive(response, response)
+	if err == nil {
+		json.Unmarshal(response.Response, list)
+	}
+
+	return &list.List, resp, relevantError(err, *response)
+}
+
+// ListShareParams are the parameters for ListService.Share
+type ListShareParams struct {
+	ListID    string   `url:"-"`
+	Broadcast []string `url:"broadcast,comma,omitempty"`
+	Message   string   `url:"message,omitempty"`
+}
+
+// Share shares a list the acting user made to the Broadcast services,
+// twitter or facebook.
+// https://developer.foursquare.com/docs/api/lists/share
+func (s *ListService) Share(params *ListShareParams) (*List, *http.Response, error) {
+	list := new(listResp)
+	response := new(Response)
+
+	resp, err := s.sling.New().Post(params.ListID+"/share").BodyForm(params).Receive(response, response)
+	if err == nil {
+		json.Unmarshal(response.Response, list)
+	}
+
+	return &list.List, resp, relevantError(err, *response)
+}
+
+// ListFollowers is the response for ListService.Followers
+type ListFollowers struct {
+	Count int    `json:"count"`
+	Items []User `json:"items"`
+}
+
+type listFollowersResp struct {
+	Followers ListFollowers `json:"followers"`
+}
+
+// Followers returns the users following a list.
+// https://developer.foursquare.com/docs/api/lists/followers
+func (s *ListService) Followers(id string) (*ListFollowers, *http.Response, error) {
+	followers := new(listFollowersResp)
+	response := new(Response)
+
+	resp, err := s.sling.New().Get(id+"/followers").Receive(response, response)
+	if err == nil {
+		json.Unmarshal(response.Response, followers)
+	}
+
+	return &followers.Followers, resp, relevantError(err, *response)
+}
+
+type listSuggestVenuesResp struct {
+	SuggestedVenues []struct {
+		Venue Venue `json:"venue"`
+	} `json:"suggestedVenues"`
+}
+
+// SuggestVenues returns venues that would be good to add to a list.
+// https://developer.foursquare.com/docs/api/lists/suggestvenues
+func (s *ListService) SuggestVenues(id string) ([]Venue, *http.Response, error) {
+	suggested := new(listSuggestVenuesResp)
+	response := new(Response)
+
+	resp, err := s.sling.New().Get(id+"/suggestvenues").Receive(response, response)
+	if err == nil {
+		json.Unmarshal(response.Response, suggested)
+	}
+
+	venues := make([]Venue, len(suggested.SuggestedVenues))
+	for i, s := range suggested.SuggestedVenues {
+		venues[i] = s.Venue
+	}
+	return venues, resp, relevantError(err, *response)
+}
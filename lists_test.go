@@ -0,0 +1,428 @@
+package foursquarego
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListService_Details(t *testing.T) {
+	const filePath = "./json/lists/details.json"
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/v2/lists/57757f23498e8e90405a5cd9", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQueryNoUser(t, map[string]string{
+			"limit": "10",
+		}, r)
+
+		b, err := getTestFile(filePath)
+		if err != nil {
+			t.Fatalf("Failed to open testfile %s", filePath)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+	})
+
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	list, _, err := client.Lists.Details(&ListDetailsParams{
+		ListID: "57757f23498e8e90405a5cd9",
+		Limit:  10,
+	})
+	assert.Nil(t, err)
+
+	assert.Equal(t, "57757f23498e8e90405a5cd9", list.ID)
+	assert.Equal(t, "Brooklyn & Queens Breweries", list.Name)
+	assert.Equal(t, "created", list.Type)
+	assert.Equal(t, true, list.Editable)
+	assert.Equal(t, "68150", list.User.ID)
+	assert.Equal(t, int64(1467401782), list.UpdatedAt.Unix())
+	assert.Equal(t, 12, list.Followers.Count)
+	assert.Equal(t, 2, list.ListItems.Count)
+	assert.Len(t, list.ListItems.Items, 2)
+	assert.Equal(t, "t5692caa3498efc71821e8c54", list.ListItems.Items[0].ID)
+	assert.Equal(t, "5692caa3498efc71821e8c54", list.ListItems.Items[0].Tip.ID)
+	assert.Equal(t, "5414d0a6498ea3d31a3c64cf", list.ListItems.Items[0].Venue.ID)
+	assert.Equal(t, "Threes Brewing", list.ListItems.Items[0].Venue.Name)
+	assert.Equal(t, "Brooklyn", list.ListItems.Items[0].Venue.Location.City)
+	assert.Equal(t, "Brewery", list.ListItems.Items[0].Venue.Categories[0].Name)
+}
+
+func TestListService_Items(t *testing.T) {
+	const filePath = "./json/lists/details.json"
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/v2/lists/57757f23498e8e90405a5cd9", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQueryNoUser(t, map[string]string{
+			"limit": "200",
+		}, r)
+
+		b, err := getTestFile(filePath)
+		if err != nil {
+			t.Fatalf("Failed to open testfile %s", filePath)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+	})
+
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	items, _, err := client.Lists.Items("57757f23498e8e90405a5cd9")
+	assert.Nil(t, err)
+
+	assert.Len(t, items, 2)
+	assert.Equal(t, "Threes Brewing", items[0].Venue.Name)
+	assert.Equal(t, "SingleCut Beersmiths", items[1].Venue.Name)
+}
+
+func TestListService_ItemsWithoutCount(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/v2/lists/57757f23498e8e90405a5cd9", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+
+		n := 200
+		if r.URL.Query().Get("offset") == "200" {
+			n = 1
+		}
+		var items []map[string]interface{}
+		for i := 0; i < n; i++ {
+			items = append(items, map[string]interface{}{"id": fmt.Sprintf("t%d", i)})
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"meta": map[string]int{"code": 200},
+			"response": map[string]interface{}{
+				"list": map[string]interface{}{"listItems": map[string]interface{}{"items": items}},
+			},
+		})
+	})
+
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	items, _, err := client.Lists.Items("57757f23498e8e90405a5cd9")
+	assert.Nil(t, err)
+	assert.Len(t, items, 201)
+}
+
+func TestListService_Add(t *testing.T) {
+	const filePath = "./json/lists/add.json"
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/v2/lists/add", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		assertQueryNoUser(t, map[string]string{}, r)
+		assertForm(t, map[string]string{
+			"name":          "Summer patios",
+			"collaborative": "true",
+		}, r)
+
+		b, err := getTestFile(filePath)
+		if err != nil {
+			t.Fatalf("Failed to open testfile %s", filePath)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+	})
+
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	list, _, err := client.Lists.Add(&ListAddParams{
+		Name:          "Summer patios",
+		Collaborative: true,
+	})
+	assert.Nil(t, err)
+
+	assert.Equal(t, "5b05a3a6a423620039ba1d9e", list.ID)
+	assert.Equal(t, "Summer patios", list.Name)
+	assert.Equal(t, 0, list.ListItems.Count)
+}
+
+func TestListService_Update(t *testing.T) {
+	const filePath = "./json/lists/add.json"
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/v2/lists/5b05a3a6a423620039ba1d9e/update", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		assertQueryNoUser(t, map[string]string{}, r)
+		assertForm(t, map[string]string{
+			"description":   "Outdoor drinking",
+			"collaborative": "false",
+		}, r)
+
+		b, err := getTestFile(filePath)
+		if err != nil {
+			t.Fatalf("Failed to open testfile %s", filePath)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+	})
+
+	collaborative := false
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	list, _, err := client.Lists.Update(&ListUpdateParams{
+		ListID:        "5b05a3a6a423620039ba1d9e",
+		Description:   "Outdoor drinking",
+		Collaborative: &collaborative,
+	})
+	assert.Nil(t, err)
+
+	assert.Equal(t, "5b05a3a6a423620039ba1d9e", list.ID)
+}
+
+func TestListService_AddItem(t *testing.T) {
+	const filePath = "./json/lists/additem.json"
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/v2/lists/57757f23498e8e90405a5cd9/additem", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		assertQueryNoUser(t, map[string]string{}, r)
+		assertForm(t, map[string]string{
+			"venueId": "49b6e8d2f964a52016531fe3",
+			"text":    "Get the Super Heebster",
+		}, r)
+
+		b, err := getTestFile(filePath)
+		if err != nil {
+			t.Fatalf("Failed to open testfile %s", filePath)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+	})
+
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	item, _, err := client.Lists.AddItem(&ListAddItemParams{
+		ListID:  "57757f23498e8e90405a5cd9",
+		VenueID: "49b6e8d2f964a52016531fe3",
+		Text:    "Get the Super Heebster",
+	})
+	assert.Nil(t, err)
+
+	assert.Equal(t, "v49b6e8d2f964a52016531fe3", item.ID)
+	assert.Equal(t, "Russ & Daughters", item.Venue.Name)
+}
+
+func TestListService_DeleteItem(t *testing.T) {
+	const filePath = "./json/lists/additem.json"
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/v2/lists/57757f23498e8e90405a5cd9/deleteitem", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		assertForm(t, map[string]string{
+			"itemId": "v49b6e8d2f964a52016531fe3",
+		}, r)
+
+		b, err := getTestFile(filePath)
+		if err != nil {
+			t.Fatalf("Failed to open testfile %s", filePath)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+	})
+
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	item, _, err := client.Lists.DeleteItem(&ListDeleteItemParams{
+		ListID: "57757f23498e8e90405a5cd9",
+		ItemID: "v49b6e8d2f964a52016531fe3",
+	})
+	assert.Nil(t, err)
+
+	assert.Equal(t, "v49b6e8d2f964a52016531fe3", item.ID)
+}
+
+func TestListService_UpdateItem(t *testing.T) {
+	const filePath = "./json/lists/additem.json"
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/v2/lists/57757f23498e8e90405a5cd9/updateitem", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		assertForm(t, map[string]string{
+			"itemId": "v49b6e8d2f964a52016531fe3",
+			"url":    "https://www.russanddaughters.com",
+		}, r)
+
+		b, err := getTestFile(filePath)
+		if err != nil {
+			t.Fatalf("Failed to open testfile %s", filePath)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+	})
+
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	item, _, err := client.Lists.UpdateItem(&ListUpdateItemParams{
+		ListID: "57757f23498e8e90405a5cd9",
+		ItemID: "v49b6e8d2f964a52016531fe3",
+		URL:    "https://www.russanddaughters.com",
+	})
+	assert.Nil(t, err)
+
+	assert.Equal(t, "v49b6e8d2f964a52016531fe3", item.ID)
+}
+
+func TestListService_MoveItem(t *testing.T) {
+	const filePath = "./json/lists/moveitem.json"
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/v2/lists/57757f23498e8e90405a5cd9/moveitem", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		assertForm(t, map[string]string{
+			"itemId":   "v4f68de6bd5fbee32e5f4f3a5",
+			"beforeId": "t5692caa3498efc71821e8c54",
+		}, r)
+
+		b, err := getTestFile(filePath)
+		if err != nil {
+			t.Fatalf("Failed to open testfile %s", filePath)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+	})
+
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	items, _, err := client.Lists.MoveItem(&ListMoveItemParams{
+		ListID:   "57757f23498e8e90405a5cd9",
+		ItemID:   "v4f68de6bd5fbee32e5f4f3a5",
+		BeforeID: "t5692caa3498efc71821e8c54",
+	})
+	assert.Nil(t, err)
+
+	assert.Equal(t, 2, items.Count)
+	assert.Equal(t, "v4f68de6bd5fbee32e5f4f3a5", items.Items[0].ID)
+	assert.Equal(t, "t5692caa3498efc71821e8c54", items.Items[1].ID)
+}
+
+func TestListService_FollowUnfollow(t *testing.T) {
+	const filePath = "./json/lists/details.json"
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		assertQueryNoUser(t, map[string]string{}, r)
+
+		b, err := getTestFile(filePath)
+		if err != nil {
+			t.Fatalf("Failed to open testfile %s", filePath)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+	}
+	mux.HandleFunc("/v2/lists/57757f23498e8e90405a5cd9/follow", handler)
+	mux.HandleFunc("/v2/lists/57757f23498e8e90405a5cd9/unfollow", handler)
+
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	list, _, err := client.Lists.Follow("57757f23498e8e90405a5cd9")
+	assert.Nil(t, err)
+	assert.Equal(t, "57757f23498e8e90405a5cd9", list.ID)
+
+	list, _, err = client.Lists.Unfollow("57757f23498e8e90405a5cd9")
+	assert.Nil(t, err)
+	assert.Equal(t, "57757f23498e8e90405a5cd9", list.ID)
+}
+
+func TestListService_Share(t *testing.T) {
+	const filePath = "./json/lists/details.json"
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/v2/lists/57757f23498e8e90405a5cd9/share", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		assertQueryNoUser(t, map[string]string{}, r)
+		assertForm(t, map[string]string{
+			"broadcast": "twitter,facebook",
+			"message":   "Brooklyn breweries",
+		}, r)
+
+		b, err := getTestFile(filePath)
+		if err != nil {
+			t.Fatalf("Failed to open testfile %s", filePath)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+	})
+
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	list, _, err := client.Lists.Share(&ListShareParams{
+		ListID:    "57757f23498e8e90405a5cd9",
+		Broadcast: []string{"twitter", "facebook"},
+		Message:   "Brooklyn breweries",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "57757f23498e8e90405a5cd9", list.ID)
+}
+
+func TestListService_Followers(t *testing.T) {
+	const filePath = "./json/lists/followers.json"
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/v2/lists/57757f23498e8e90405a5cd9/followers", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQueryNoUser(t, map[string]string{}, r)
+
+		b, err := getTestFile(filePath)
+		if err != nil {
+			t.Fatalf("Failed to open testfile %s", filePath)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+	})
+
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	followers, _, err := client.Lists.Followers("57757f23498e8e90405a5cd9")
+	assert.Nil(t, err)
+
+	assert.Equal(t, 2, followers.Count)
+	assert.Equal(t, "Valerie", followers.Items[0].FirstName)
+	assert.Equal(t, "venuePage", followers.Items[1].Type)
+}
+
+func TestListService_SuggestVenues(t *testing.T) {
+	const filePath = "./json/lists/suggestvenues.json"
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/v2/lists/57757f23498e8e90405a5cd9/suggestvenues", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQueryNoUser(t, map[string]string{}, r)
+
+		b, err := getTestFile(filePath)
+		if err != nil {
+			t.Fatalf("Failed to open testfile %s", filePath)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+	})
+
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	venues, _, err := client.Lists.SuggestVenues("57757f23498e8e90405a5cd9")
+	assert.Nil(t, err)
+
+	assert.Len(t, venues, 2)
+	assert.Equal(t, "49b6e8d2f964a52016531fe3", venues[0].ID)
+	assert.Equal(t, "Russ & Daughters", venues[0].Name)
+}
@@ -0,0 +1,208 @@
+package foursquarego
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// MatchRecord is a place from your own data that should be matched
+// against foursquare venues.
+type MatchRecord struct {
+	Name       string
+	Address    string
+	City       string
+	State      string
+	PostalCode string
+	Lat        float64
+	Lng        float64
+	Phone      string
+}
+
+// Match is a candidate venue for a MatchRecord. Confidence is from 0 (no
+// match) to 1 (certain) and Reasons explains how it was scored.
+type Match struct {
+	Venue      Venue
+	Confidence float64
+	Reasons    []string
+}
+
+// Matcher finds the foursquare venues that are most likely the same place
+// as a MatchRecord. It searches with IntentMatch first and falls back to
+// broader searches when nothing scores at least MinConfidence.
+type Matcher struct {
+	venues *VenueService
+
+	// Radius in meters for the fallback searches. Candidates further away
+	// than this get no credit for distance.
+	Radius int
+	// Limit is the number of results asked for on each fallback search.
+	Limit int
+	// MinConfidence a match needs before the fallback searches are skipped.
+	MinConfidence float64
+}
+
+// NewMatcher returns a Matcher that searches with the given VenueService.
+func NewMatcher(venues *VenueService) *Matcher {
+	return &Matcher{
+		venues:        venues,
+		Radius:        500,
+		Limit:         20,
+		MinConfidence: 0.6,
+	}
+}
+
+// Match searches for venues like the record and returns them ranked by
+// confidence, best first. The http.Response is from the last search made.
+func (m *Matcher) Match(record MatchRecord) ([]Match, *http.Response, error) {
+	ll := LatLong{Lat: record.Lat, Lng: record.Lng}.String()
+	searches := []*VenueSearchParams{
+		{
+			LatLong: ll,
+			Query:   record.Name,
+			Intent:  IntentMatch,
+			Name:    record.Name,
+			Address: record.Address,
+			City:    record.City,
+			State:   record.State,
+			Zip:     record.PostalCode,
+			Phone:   record.Phone,
+		},
+		{LatLong: ll, Query: record.Name, Intent: IntentCheckin, Radius: m.Radius, Limit: m.Limit},
+		{LatLong: ll, Query: record.Address, Intent: IntentBrowse, Radius: m.Radius, Limit: m.Limit},
+	}
+
+	var matches []Match
+	var resp *http.Response
+	seen := make(map[string]bool)
+	for _, params := range searches {
+		if params.Query == "" {
+			continue
+		}
+
+		var venues []Venue
+		var err error
+		venues, resp, err = m.venues.Search(params)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		for _, v := range venues {
+			if seen[v.ID] {
+				continue
+			}
+			seen[v.ID] = true
+			matches = append(matches, m.score(record, v))
+		}
+
+		sort.SliceStable(matches, func(i, j int) bool {
+			return matches[i].Confidence > matches[j].Confidence
+		})
+		if len(matches) > 0 && matches[0].Confidence >= m.MinConfidence {
+			break
+		}
+	}
+
+	return matches, resp, nil
+}
+
+func (m *Matcher) score(record MatchRecord, v Venue) Match {
+	match := Match{Venue: v}
+
+	name := nameSimilarity(record.Name, v.Name)
+	match.Reasons = append(match.Reasons, fmt.Sprintf("name similarity %.2f", name))
+
+	meters := float64(v.Location.Distance)
+	if meters == 0 {
+		meters = Distance(LatLong{Lat: record.Lat, Lng: record.Lng}, v)
+	}
+	distance := 0.0
+	if m.Radius > 0 && meters < float64(m.Radius) {
+		distance = 1 - meters/float64(m.Radius)
+	}
+	match.Reasons = append(match.Reasons, fmt.Sprintf("%.0fm away", meters))
+
+	ours, theirs := phoneDigits(record.Phone), phoneDigits(v.Contact.Phone)
+	if ours == "" || theirs == "" {
+		match.Confidence = 0.6*name + 0.4*distance
+		return match
+	}
+
+	phone := 0.0
+	if ours == theirs {
+		phone = 1
+		match.Reasons = append(match.Reasons, "phone matches")
+	} else {
+		match.Reasons = append(match.Reasons, "phone differs")
+	}
+	match.Confidence = 0.5*name + 0.3*distance + 0.2*phone
+	return match
+}
+
+// nameSimilarity is the Dice coefficient of the character bigrams in both
+// normalized names.
+func nameSimilarity(a, b string) float64 {
+	a, b = normalizeName(a), normalizeName(b)
+	if a == "" || b == "" {
+		return 0
+	}
+	if a == b {
+		return 1
+	}
+
+	bigrams := func(s string) map[string]int {
+		r := []rune(s)
+		m := make(map[string]int)
+		for i := 0; i < len(r)-1; i++ {
+			m[string(r[i:i+2])]++
+		}
+		return m
+	}
+	ab, bb := bigrams(a), bigrams(b)
+
+	total, shared := 0, 0
+	for g, n := range ab {
+		total += n
+		if bn, ok := bb[g]; ok {
+			if bn < n {
+				shared += bn
+			} else {
+				shared += n
+			}
+		}
+	}
+	for _, n := range bb {
+		total += n
+	}
+	if total == 0 {
+		return 0
+	}
+	return 2 * float64(shared) / float64(total)
+}
+
+// normalizeName lowercases the name, spells out "&" and drops a leading
+// "the", punctuation and spaces.
+func normalizeName(s string) string {
+	s = strings.ToLower(strings.Replace(s, "&", " and ", -1))
+	s = strings.TrimPrefix(strings.TrimSpace(s), "the ")
+
+	var b strings.Builder
+	for _, r := range s {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// phoneDigits keeps the last 10 digits of a phone number so that country
+// codes and formatting don't matter.
+func phoneDigits(s string) string {
+	d := digits(s)
+	if len(d) > 10 {
+		d = d[len(d)-10:]
+	}
+	return d
+}
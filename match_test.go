@@ -0,0 +1,98 @@
+package foursquarego
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatcher_Match(t *testing.T) {
+	const filePath = "./json/venues/search.json"
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	var intents []string
+	mux.HandleFunc("/v2/venues/search", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		intents = append(intents, r.URL.Query().Get("intent"))
+		assertQueryNoUser(t, map[string]string{
+			"ll":      "40.7783,-73.9019",
+			"query":   "Singlecut Beersmiths",
+			"intent":  "match",
+			"name":    "Singlecut Beersmiths",
+			"address": "19-33 37th St",
+			"city":    "Astoria",
+			"state":   "NY",
+			"zip":     "11105",
+			"phone":   "+1 (718) 606-0788",
+		}, r)
+
+		b, err := getTestFile(filePath)
+		if err != nil {
+			t.Fatalf("Failed to open testfile %s", filePath)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+	})
+
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	matches, _, err := NewMatcher(client.Venues).Match(MatchRecord{
+		Name:       "Singlecut Beersmiths",
+		Address:    "19-33 37th St",
+		City:       "Astoria",
+		State:      "NY",
+		PostalCode: "11105",
+		Lat:        40.7783,
+		Lng:        -73.9019,
+		Phone:      "+1 (718) 606-0788",
+	})
+	assert.Nil(t, err)
+
+	assert.Equal(t, []string{"match"}, intents)
+	assert.Len(t, matches, 1)
+	assert.Equal(t, "4f68de6bd5fbee32e5f4f3a5", matches[0].Venue.ID)
+	assert.InDelta(t, 0.7, matches[0].Confidence, 0.001)
+	assert.Equal(t, []string{"name similarity 1.00", "12025m away", "phone matches"}, matches[0].Reasons)
+}
+
+func TestMatcher_MatchFallback(t *testing.T) {
+	const filePath = "./json/venues/search.json"
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	var intents []string
+	mux.HandleFunc("/v2/venues/search", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		intents = append(intents, r.URL.Query().Get("intent"))
+
+		b, err := getTestFile(filePath)
+		if err != nil {
+			t.Fatalf("Failed to open testfile %s", filePath)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+	})
+
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	matches, _, err := NewMatcher(client.Venues).Match(MatchRecord{
+		Name:    "Astoria Taproom",
+		Address: "19-33 37th St",
+		Lat:     40.7783,
+		Lng:     -73.9019,
+	})
+	assert.Nil(t, err)
+
+	assert.Equal(t, []string{"match", "checkin", "browse"}, intents)
+	assert.Len(t, matches, 1)
+	assert.True(t, matches[0].Confidence < 0.6)
+}
+
+func TestNameSimilarity(t *testing.T) {
+	assert.Equal(t, 1.0, nameSimilarity("The Tap & Barrel", "tap and barrel"))
+	assert.Equal(t, 0.0, nameSimilarity("", "Threes Brewing"))
+	assert.True(t, nameSimilarity("Threes Brewing", "Threes Brewery") > 0.7)
+	assert.True(t, nameSimilarity("Threes Brewing", "Singlecut Beersmiths") < 0.3)
+}
@@ -0,0 +1,237 @@
+package foursquarego
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// MenuItem is a SubEntry of a menu with its prices parsed. Section is the
+// path to the item, the menu name and then the section name. Prices are
+// in the minor units of Currency, 1250 is $12.50, and RawPrices keeps the
+// strings that couldn't be parsed such as "Market Price".
+type MenuItem struct {
+	ID          string
+	Name        string
+	Description string
+	Section     []string
+	Currency    string
+	Prices      []int64
+	RawPrices   []string
+	Options     []MenuModifier
+	Additions   []MenuModifier
+}
+
+// MenuModifier is an option or addition of a MenuItem, a size or an extra
+// topping for example. Group is the name of the group it was listed under,
+// if any. Prices are in the minor units of the item's Currency.
+type MenuModifier struct {
+	ID          string
+	Group       string
+	Name        string
+	Description string
+	Prices      []int64
+}
+
+// Items flattens the menus into MenuItems. cc is the country code of the
+// venue, its Location.Cc, and picks the currency. An unknown cc leaves the
+// Currency empty and prices are then read with two decimals.
+func (m MenuResp) Items(cc string) []MenuItem {
+	currency := CurrencyForCountry(cc)
+
+	var items []MenuItem
+	for _, menu := range m.Menus.Items {
+		for _, section := range menu.Entries.Items {
+			for _, entry := range section.Entries.Items {
+				item := MenuItem{
+					ID:          entry.EntryID,
+					Name:        entry.Name,
+					Description: entry.Description,
+					Section:     []string{menu.Name, section.Name},
+					Currency:    currency,
+					Options:     menuModifiers(entry.Options, currency),
+					Additions:   menuModifiers(entry.Additions, currency),
+				}
+
+				prices := entry.Prices
+				if len(prices) == 0 && entry.Price != "" {
+					prices = []string{entry.Price}
+				}
+				for _, p := range prices {
+					if amount, err := ParsePrice(p, currency); err == nil {
+						item.Prices = append(item.Prices, amount)
+					} else {
+						item.RawPrices = append(item.RawPrices, p)
+					}
+				}
+
+				items = append(items, item)
+			}
+		}
+	}
+	return items
+}
+
+// menuModifierJSON is the shape options and additions are read with. They
+// can be a plain list or a {"count", "items"} object and a group of
+// modifiers has its own entries.
+type menuModifierJSON struct {
+	ID          string          `json:"id"`
+	EntryID     string          `json:"entryId"`
+	OptionID    string          `json:"optionId"`
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	Price       string          `json:"price"`
+	Prices      []string        `json:"prices"`
+	Entries     json.RawMessage `json:"entries"`
+}
+
+func menuModifiers(raw Omitted, currency string) []MenuModifier {
+	if raw == nil {
+		return nil
+	}
+	b, err := json.Marshal(raw)
+	if err != nil {
+		return nil
+	}
+
+	var modifiers []MenuModifier
+	for _, m := range decodeMenuModifiers(b) {
+		group := decodeMenuModifiers(m.Entries)
+		if len(group) == 0 {
+			modifiers = append(modifiers, m.modifier("", currency))
+			continue
+		}
+		for _, g := range group {
+			modifiers = append(modifiers, g.modifier(m.Name, currency))
+		}
+	}
+	return modifiers
+}
+
+func decodeMenuModifiers(b []byte) []menuModifierJSON {
+	var list []menuModifierJSON
+	if json.Unmarshal(b, &list) == nil {
+		return list
+	}
+	var items struct {
+		Items []menuModifierJSON `json:"items"`
+	}
+	json.Unmarshal(b, &items)
+	return items.Items
+}
+
+func (m menuModifierJSON) modifier(group, currency string) MenuModifier {
+	modifier := MenuModifier{
+		ID:          m.ID,
+		Group:       group,
+		Name:        m.Name,
+		Description: m.Description,
+	}
+	if modifier.ID == "" {
+		modifier.ID = m.EntryID
+	}
+	if modifier.ID == "" {
+		modifier.ID = m.OptionID
+	}
+
+	prices := m.Prices
+	if len(prices) == 0 && m.Price != "" {
+		prices = []string{m.Price}
+	}
+	for _, p := range prices {
+		if amount, err := ParsePrice(p, currency); err == nil {
+			modifier.Prices = append(modifier.Prices, amount)
+		}
+	}
+	return modifier
+}
+
+var priceNumber = regexp.MustCompile(`[0-9][0-9.,]*`)
+
+// ParsePrice reads the first amount in a menu price such as "$12.50",
+// "12,50 €" or "¥1,200" and returns it in the minor units of currency.
+// Both . and , are understood as decimal and thousands separators.
+func ParsePrice(s, currency string) (int64, error) {
+	number := strings.TrimRight(priceNumber.FindString(s), ".,")
+	if number == "" {
+		return 0, fmt.Errorf("foursquarego: no price in %q", s)
+	}
+	exponent := CurrencyExponent(currency)
+
+	whole, fraction := number, ""
+	if i := strings.LastIndexAny(number, ".,"); i >= 0 {
+		sep := number[i]
+		other := byte(',')
+		if sep == ',' {
+			other = '.'
+		}
+		switch {
+		case strings.IndexByte(number, other) >= 0:
+			// Both are used so the last one is the decimal separator.
+			whole, fraction = number[:i], number[i+1:]
+		case strings.Count(number, string(sep)) > 1:
+			// Only thousands separators repeat.
+		case len(number)-i-1 == 3 && exponent != 3:
+			// 1,200 is read as twelve hundred.
+		default:
+			whole, fraction = number[:i], number[i+1:]
+		}
+	}
+	whole = strings.NewReplacer(".", "", ",", "").Replace(whole)
+
+	amount, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("foursquarego: bad price %q: %v", s, err)
+	}
+	for i := 0; i < exponent; i++ {
+		amount *= 10
+		if i < len(fraction) {
+			amount += int64(fraction[i] - '0')
+		}
+	}
+	if len(fraction) > exponent && fraction[exponent] >= '5' {
+		amount++
+	}
+	return amount, nil
+}
+
+// CurrencyForCountry returns the ISO 4217 code of the currency used in a
+// country, by its ISO 3166 code as in Location.Cc. It is empty when the
+// country isn't known.
+func CurrencyForCountry(cc string) string {
+	return countryCurrencies[strings.ToUpper(cc)]
+}
+
+// CurrencyExponent is the number of minor unit digits of a currency, 2 for
+// USD and 0 for JPY. Unknown currencies have 2.
+func CurrencyExponent(currency string) int {
+	if e, ok := currencyExponents[strings.ToUpper(currency)]; ok {
+		return e
+	}
+	return 2
+}
+
+var currencyExponents = map[string]int{
+	"BHD": 3, "CLP": 0, "ISK": 0, "JOD": 3, "JPY": 0, "KRW": 0,
+	"KWD": 3, "OMR": 3, "PYG": 0, "TND": 3, "UGX": 0, "VND": 0,
+}
+
+var countryCurrencies = map[string]string{
+	"AE": "AED", "AR": "ARS", "AT": "EUR", "AU": "AUD", "BE": "EUR",
+	"BG": "BGN", "BH": "BHD", "BR": "BRL", "CA": "CAD", "CH": "CHF",
+	"CL": "CLP", "CN": "CNY", "CO": "COP", "CY": "EUR", "CZ": "CZK",
+	"DE": "EUR", "DK": "DKK", "EE": "EUR", "EG": "EGP", "ES": "EUR",
+	"FI": "EUR", "FR": "EUR", "GB": "GBP", "GR": "EUR", "HK": "HKD",
+	"HR": "EUR", "HU": "HUF", "ID": "IDR", "IE": "EUR", "IL": "ILS",
+	"IN": "INR", "IS": "ISK", "IT": "EUR", "JO": "JOD", "JP": "JPY",
+	"KR": "KRW", "KW": "KWD", "LT": "EUR", "LU": "EUR", "LV": "EUR",
+	"MT": "EUR", "MX": "MXN", "MY": "MYR", "NL": "EUR", "NO": "NOK",
+	"NZ": "NZD", "OM": "OMR", "PE": "PEN", "PH": "PHP", "PL": "PLN",
+	"PT": "EUR", "PY": "PYG", "QA": "QAR", "RO": "RON", "RU": "RUB",
+	"SA": "SAR", "SE": "SEK", "SG": "SGD", "SI": "EUR", "SK": "EUR",
+	"TH": "THB", "TN": "TND", "TR": "TRY", "TW": "TWD", "UA": "UAH",
+	"UG": "UGX", "US": "USD", "VN": "VND", "ZA": "ZAR",
+}
@@ -0,0 +1,116 @@
+package foursquarego
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMenuResp_Items(t *testing.T) {
+	b, err := getTestFile("./json/venues/menu.json")
+	assert.Nil(t, err)
+
+	var r Response
+	assert.Nil(t, json.Unmarshal(b, &r))
+	menu := new(venueMenuResp)
+	assert.Nil(t, json.Unmarshal(r.Response, menu))
+
+	items := menu.Menu.Items("US")
+	assert.Len(t, items, 79)
+
+	assert.Equal(t, MenuItem{
+		ID:          "62463379",
+		Name:        "Our Favorite Anchovies",
+		Description: "Pickled chili condiment, tartine flatbread.",
+		Section:     []string{"Main Menu", "Raw Bar"},
+		Currency:    "USD",
+		Prices:      []int64{1250},
+	}, items[7])
+	assert.Nil(t, items[5].Prices)
+	assert.Equal(t, "Half Dozen Oysters Or Clams On the Half Shell", items[5].Name)
+}
+
+func TestMenuResp_ItemsModifiers(t *testing.T) {
+	var options, additions Omitted
+	assert.Nil(t, json.Unmarshal([]byte(`{"count": 1, "items": [
+		{"name": "Size", "entries": {"count": 2, "items": [
+			{"entryId": "1", "name": "Small", "price": "8,00"},
+			{"entryId": "2", "name": "Large", "prices": ["11,50"]}
+		]}}
+	]}`), &options))
+	assert.Nil(t, json.Unmarshal([]byte(`[{"id": "3", "name": "Extra cheese", "price": "1,5"}, {"name": "Bread"}]`), &additions))
+
+	menu := MenuResp{Menus: Menus{Items: []FullMenu{{
+		Name: "Lunch",
+		Entries: Entries{Items: []Entry{{
+			Name: "Pizza",
+			Entries: SubEntries{Items: []SubEntry{{
+				EntryID:   "10",
+				Name:      "Margherita",
+				Price:     "ab 8,00 €",
+				Options:   options,
+				Additions: additions,
+			}, {
+				Name:   "Special",
+				Prices: []string{"Market Price"},
+			}}},
+		}}},
+	}}}}
+
+	items := menu.Items("de")
+	assert.Equal(t, "EUR", items[0].Currency)
+	assert.Equal(t, []string{"Lunch", "Pizza"}, items[0].Section)
+	assert.Equal(t, []int64{800}, items[0].Prices)
+	assert.Equal(t, []MenuModifier{
+		{ID: "1", Group: "Size", Name: "Small", Prices: []int64{800}},
+		{ID: "2", Group: "Size", Name: "Large", Prices: []int64{1150}},
+	}, items[0].Options)
+	assert.Equal(t, []MenuModifier{
+		{ID: "3", Name: "Extra cheese", Prices: []int64{150}},
+		{Name: "Bread"},
+	}, items[0].Additions)
+
+	assert.Nil(t, items[1].Prices)
+	assert.Equal(t, []string{"Market Price"}, items[1].RawPrices)
+	assert.Nil(t, items[1].Options)
+}
+
+func TestParsePrice(t *testing.T) {
+	tests := []struct {
+		price    string
+		currency string
+		amount   int64
+	}{
+		{"16.00", "USD", 1600},
+		{"$12.50", "USD", 1250},
+		{"$12.5", "USD", 1250},
+		{"$9", "", 900},
+		{"$1,200", "USD", 120000},
+		{"$1,234.56", "USD", 123456},
+		{"12,50 €", "EUR", 1250},
+		{"1.234,56 €", "EUR", 123456},
+		{"¥1,200", "JPY", 1200},
+		{"¥1.200", "JPY", 1200},
+		{"1.500 KD", "KWD", 1500},
+		{"4.995", "KWD", 4995},
+		{"12.00 - 15.00", "USD", 1200},
+		{"2.345.678", "USD", 234567800},
+	}
+	for _, test := range tests {
+		amount, err := ParsePrice(test.price, test.currency)
+		assert.Nil(t, err, test.price)
+		assert.Equal(t, test.amount, amount, test.price)
+	}
+
+	_, err := ParsePrice("Market Price", "USD")
+	assert.NotNil(t, err)
+}
+
+func TestCurrencyForCountry(t *testing.T) {
+	assert.Equal(t, "USD", CurrencyForCountry("US"))
+	assert.Equal(t, "EUR", CurrencyForCountry("fr"))
+	assert.Equal(t, "", CurrencyForCountry("XX"))
+	assert.Equal(t, 0, CurrencyExponent("JPY"))
+	assert.Equal(t, 2, CurrencyExponent(""))
+}
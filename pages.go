@@ -0,0 +1,139 @@
+package foursquarego
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/dghubble/sling"
+)
+
+// PageService provides a method for accessing Foursquare page endpoints
+type PageService struct {
+	sling *sling.Sling
+}
+
+func newPageService(sling *sling.Sling) *PageService {
+	return &PageService{
+		sling: sling.Path("pages/"),
+	}
+}
+
+// Details gets the user that is the branded page.
+// https://developer.foursquare.com/docs/api/pages/details
+func (s *PageService) Details(id string) (*Page, *http.Response, error) {
+	page := new(Page)
+	response := new(Response)
+
+	resp, err := s.sling.New().Get(id).Receive(response, response)
+	if err == nil {
+		json.Unmarshal(response.Response, page)
+	}
+
+	return page, resp, relevantError(err, *response)
+}
+
+// PageVenuesParams are the parameters for PageService.Venues
+type PageVenuesParams struct {
+	PageID  string `url:"-"`
+	LatLong string `url:"ll,omitempty"`
+	Radius  int    `url:"radius,omitempty"`
+	Sw      string `url:"sw,omitempty"`
+	Ne      string `url:"ne,omitempty"`
+	Offset  int    `url:"offset,omitempty"`
+	Limit   int    `url:"limit,omitempty"`
+	StoreID string `url:"storeId,omitempty"`
+}
+
+// PageVenues is the response for PageService.Venues. Count is the total
+// number of venues for the page.
+type PageVenues struct {
+	Count int     `json:"count"`
+	Items []Venue `json:"items"`
+}
+
+type pageVenuesResp struct {
+	Venues PageVenues `json:"venues"`
+}
+
+// Venues returns the venues belonging to a page.
+// https://developer.foursquare.com/docs/api/pages/venues
+func (s *PageService) Venues(params *PageVenuesParams) (*PageVenues, *http.Response, error) {
+	venues := new(pageVenuesResp)
+	response := new(Response)
+
+	resp, err := s.sling.New().Get(params.PageID+"/venues").QueryStruct(params).Receive(response, response)
+	if err == nil {
+		json.Unmarshal(response.Response, venues)
+	}
+
+	return &venues.Venues, resp, relevantError(err, *response)
+}
+
+type pageManagingResp struct {
+	Managing struct {
+		Count int    `json:"count"`
+		Items []User `json:"items"`
+	} `json:"managing"`
+}
+
+// ManagedPages returns the pages the acting user manages.
+// https://developer.foursquare.com/docs/api/pages/managing
+func (s *PageService) ManagedPages() ([]Page, *http.Response, error) {
+	managing := new(pageManagingResp)
+	response := new(Response)
+
+	resp, err := s.sling.New().Get("managing").Receive(response, response)
+	if err == nil {
+		json.Unmarshal(response.Response, managing)
+	}
+
+	pages := make([]Page, len(managing.Managing.Items))
+	for i, u := range managing.Managing.Items {
+		pages[i] = Page{User: u}
+	}
+
+	return pages, resp, relevantError(err, *response)
+}
+
+// TimeSeriesField are the statistics that can be asked for in a
+// timeseries request.
+type TimeSeriesField string
+
+// Options for TimeSeriesField
+const (
+	FieldTotalCheckins  TimeSeriesField = "totalCheckins"
+	FieldNewCheckins    TimeSeriesField = "newCheckins"
+	FieldUniqueVisitors TimeSeriesField = "uniqueVisitors"
+	FieldSharing        TimeSeriesField = "sharing"
+	FieldGenders        TimeSeriesField = "genders"
+	FieldAges           TimeSeriesField = "ages"
+	FieldHours          TimeSeriesField = "hours"
+)
+
+// PageTimeseriesParams are the parameters for PageService.Timeseries. If
+// EndAt is not set foursquare uses the current time.
+type PageTimeseriesParams struct {
+	PageID  string            `url:"pageId"`
+	StartAt time.Time         `url:"startAt,unix"`
+	EndAt   time.Time         `url:"endAt,unix,omitempty"`
+	Fields  []TimeSeriesField `url:"fields,comma,omitempty"`
+}
+
+type timeseriesResp struct {
+	TimeSeries []TimeSeries `json:"timeseries"`
+}
+
+// Timeseries returns daily stats for each venue the page manages.
+// https://developer.foursquare.com/docs/api/pages/timeseries
+func (s *PageService) Timeseries(params *PageTimeseriesParams) ([]TimeSeries, *http.Response, error) {
+	series := new(timeseriesResp)
+	response := new(Response)
+
+	resp, err := s.sling.New().Get("timeseries").QueryStruct(params).Receive(response, response)
+	if err == nil {
+		json.Unmarshal(response.Response, series)
+	}
+
+	return series.TimeSeries, resp, relevantError(err, *response)
+}
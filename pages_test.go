@@ -0,0 +1,144 @@
+package foursquarego
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPageService_Details(t *testing.T) {
+	const filePath = "./json/pages/details.json"
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/v2/pages/1070527", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQueryNoUser(t, map[string]string{}, r)
+
+		b, err := getTestFile(filePath)
+		if err != nil {
+			t.Fatalf("Failed to open testfile %s", filePath)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+	})
+
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	page, _, err := client.Pages.Details("1070527")
+	assert.Nil(t, err)
+
+	assert.Equal(t, "1070527", page.User.ID)
+	assert.Equal(t, "Joe Coffee", page.User.FirstName)
+	assert.Equal(t, "chain", page.User.Type)
+	assert.Equal(t, 12, page.User.Tips.Count)
+	assert.Equal(t, "joecoffee", page.User.Contact.Twitter)
+}
+
+func TestPageService_Venues(t *testing.T) {
+	const filePath = "./json/pages/venues.json"
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/v2/pages/1070527/venues", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQueryNoUser(t, map[string]string{
+			"ll":     "40.74,-74.0",
+			"radius": "2000",
+			"limit":  "2",
+		}, r)
+
+		b, err := getTestFile(filePath)
+		if err != nil {
+			t.Fatalf("Failed to open testfile %s", filePath)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+	})
+
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	venues, _, err := client.Pages.Venues(&PageVenuesParams{
+		PageID:  "1070527",
+		LatLong: "40.74,-74.0",
+		Radius:  2000,
+		Limit:   2,
+	})
+	assert.Nil(t, err)
+
+	assert.Equal(t, 14, venues.Count)
+	assert.Len(t, venues.Items, 2)
+	assert.Equal(t, "49e4e3b2f964a52062641fe3", venues.Items[0].ID)
+	assert.Equal(t, "waverly", venues.Items[0].StoreID)
+	assert.Equal(t, "Coffee Shop", venues.Items[1].Categories[0].Name)
+}
+
+func TestPageService_ManagedPages(t *testing.T) {
+	const filePath = "./json/pages/managing.json"
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/v2/pages/managing", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQueryNoUser(t, map[string]string{}, r)
+
+		b, err := getTestFile(filePath)
+		if err != nil {
+			t.Fatalf("Failed to open testfile %s", filePath)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+	})
+
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	pages, _, err := client.Pages.ManagedPages()
+	assert.Nil(t, err)
+
+	assert.Len(t, pages, 2)
+	assert.Equal(t, "1070527", pages[0].User.ID)
+	assert.Equal(t, "venuePage", pages[1].User.Type)
+	assert.Equal(t, "49e4e3b2f964a52062641fe3", pages[1].User.Venue.ID)
+}
+
+func TestPageService_Timeseries(t *testing.T) {
+	const filePath = "./json/pages/timeseries.json"
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/v2/pages/timeseries", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQueryNoUser(t, map[string]string{
+			"pageId":  "1070527",
+			"startAt": "1527811200",
+			"endAt":   "1528070400",
+			"fields":  "totalCheckins,newCheckins",
+		}, r)
+
+		b, err := getTestFile(filePath)
+		if err != nil {
+			t.Fatalf("Failed to open testfile %s", filePath)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+	})
+
+	start := time.Unix(1527811200, 0)
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	series, _, err := client.Pages.Timeseries(&PageTimeseriesParams{
+		PageID:  "1070527",
+		StartAt: start,
+		EndAt:   start.AddDate(0, 0, 3),
+		Fields:  []TimeSeriesField{FieldTotalCheckins, FieldNewCheckins},
+	})
+	assert.Nil(t, err)
+
+	assert.Len(t, series, 2)
+	assert.Equal(t, "49e4e3b2f964a52062641fe3", series[0].VenueID)
+	assert.Equal(t, 92, series[0].TotalCheckins.Total)
+	assert.Equal(t, [2]int64{1527897600, 34}, series[0].TotalCheckins.Data[1])
+	assert.Len(t, series[1].NewCheckins.Data, 3)
+}
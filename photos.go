@@ -0,0 +1,108 @@
+package foursquarego
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"sort"
+
+	"github.com/dghubble/sling"
+	"github.com/google/go-querystring/query"
+)
+
+// PhotoService provides a method for accessing Foursquare photo endpoints
+type PhotoService struct {
+	sling *sling.Sling
+}
+
+func newPhotoService(sling *sling.Sling) *PhotoService {
+	return &PhotoService{
+		sling: sling.Path("photos/"),
+	}
+}
+
+type photoResp struct {
+	Photo Photo `json:"photo"`
+}
+
+// Details gets all the data for a photo
+// https://developer.foursquare.com/docs/api/photos/details
+func (s *PhotoService) Details(id string) (*Photo, *http.Response, error) {
+	photo := new(photoResp)
+	response := new(Response)
+
+	resp, err := s.sling.New().Get(id).Receive(response, response)
+	if err == nil {
+		json.Unmarshal(response.Response, photo)
+	}
+
+	return &photo.Photo, resp, relevantError(err, *response)
+}
+
+// PhotoAddParams are the parameters for PhotoService.Add. Set one of
+// CheckinID, TipID, VenueID or PageID for what the photo is of. Public is
+// a pointer so the photo can be made private.
+type PhotoAddParams struct {
+	CheckinID        string `url:"checkinId,omitempty"`
+	TipID            string `url:"tipId,omitempty"`
+	VenueID          string `url:"venueId,omitempty"`
+	PageID           string `url:"pageId,omitempty"`
+	Broadcast        string `url:"broadcast,omitempty"`
+	Public           *bool  `url:"public,int,omitempty"`
+	LatLong          string `url:"ll,omitempty"`
+	LatLongAccuracy  int    `url:"llAcc,omitempty"`
+	Altitude         int    `url:"alt,omitempty"`
+	AltitudeAccuracy int    `url:"altAcc,omitempty"`
+	PostURL          string `url:"postUrl,omitempty"`
+	PostContentID    string `url:"postContentId,omitempty"`
+	PostText         string `url:"postText,omitempty"`
+}
+
+// Add uploads a JPEG photo for the acting user.
+// https://developer.foursquare.com/docs/api/photos/add
+func (s *PhotoService) Add(params *PhotoAddParams, jpeg io.Reader) (*Photo, *http.Response, error) {
+	body := new(bytes.Buffer)
+	w := multipart.NewWriter(body)
+
+	values, err := query.Values(params)
+	if err != nil {
+		return nil, nil, err
+	}
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if err := w.WriteField(key, values.Get(key)); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	h := make(textproto.MIMEHeader)
+	h.Set("Content-Disposition", `form-data; name="photo"; filename="photo.jpg"`)
+	h.Set("Content-Type", "image/jpeg")
+	part, err := w.CreatePart(h)
+	if err != nil {
+		return nil, nil, err
+	}
+	if _, err := io.Copy(part, jpeg); err != nil {
+		return nil, nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, nil, err
+	}
+
+	photo := new(photoResp)
+	response := new(Response)
+
+	resp, err := s.sling.New().Post("add").Body(body).Set("Content-Type", w.FormDataContentType()).Receive(response, response)
+	if err == nil {
+		json.Unmarshal(response.Response, photo)
+	}
+
+	return &photo.Photo, resp, relevantError(err, *response)
+}
@@ -0,0 +1,93 @@
+package foursquarego
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPhotoService_Details(t *testing.T) {
+	const filePath = "./json/photos/details.json"
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/v2/photos/5b0da4b1a6031c002c6c9d2e", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQueryNoUser(t, map[string]string{}, r)
+
+		b, err := getTestFile(filePath)
+		if err != nil {
+			t.Fatalf("Failed to open testfile %s", filePath)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+	})
+
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	photo, _, err := client.Photos.Details("5b0da4b1a6031c002c6c9d2e")
+	assert.Nil(t, err)
+
+	assert.Equal(t, "5b0da4b1a6031c002c6c9d2e", photo.ID)
+	assert.Equal(t, int64(1527620785), photo.CreatedAt.Unix())
+	assert.Equal(t, "Foursquare for Android", photo.Source.Name)
+	assert.Equal(t, "https://igx.4sqi.net/img/general/", photo.Prefix)
+	assert.Equal(t, 1440, photo.Width)
+	assert.Equal(t, 1920, photo.Height)
+	assert.Equal(t, "68150", photo.User.ID)
+	assert.Equal(t, "5414d0a6498ea3d31a3c64cf", photo.Venue.ID)
+	assert.Nil(t, photo.Tip)
+}
+
+func TestPhotoService_Add(t *testing.T) {
+	const filePath = "./json/photos/details.json"
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/v2/photos/add", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		assertQueryNoUser(t, map[string]string{}, r)
+		assert.True(t, strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data; boundary="))
+
+		err := r.ParseMultipartForm(1 << 20)
+		assert.Nil(t, err)
+		assert.Equal(t, map[string][]string{
+			"venueId":  {"5414d0a6498ea3d31a3c64cf"},
+			"public":   {"0"},
+			"ll":       {"40.68,-73.98"},
+			"postUrl":  {"http://www.threesbrewing.com"},
+			"postText": {"Patio season"},
+		}, r.MultipartForm.Value)
+
+		file, header, err := r.FormFile("photo")
+		if assert.Nil(t, err) {
+			assert.Equal(t, "image/jpeg", header.Header.Get("Content-Type"))
+			b, _ := ioutil.ReadAll(file)
+			assert.Equal(t, "\xff\xd8\xff\xe0 not really a jpeg", string(b))
+		}
+
+		b, err := getTestFile(filePath)
+		if err != nil {
+			t.Fatalf("Failed to open testfile %s", filePath)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+	})
+
+	public := false
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	photo, _, err := client.Photos.Add(&PhotoAddParams{
+		VenueID:  "5414d0a6498ea3d31a3c64cf",
+		Public:   &public,
+		LatLong:  "40.68,-73.98",
+		PostURL:  "http://www.threesbrewing.com",
+		PostText: "Patio season",
+	}, strings.NewReader("\xff\xd8\xff\xe0 not really a jpeg"))
+	assert.Nil(t, err)
+
+	assert.Equal(t, "5b0da4b1a6031c002c6c9d2e", photo.ID)
+}
@@ -0,0 +1,442 @@
+package foursquarego
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/dghubble/sling"
+)
+
+const placesBaseURL = "https://api.foursquare.com/v3/"
+
+// PlaceService provides a method for accessing the Foursquare Places API v3.
+// It authenticates with an API key instead of a client id and secret.
+// https://location.foursquare.com/developer/reference/places-api-overview
+type PlaceService struct {
+	sling *sling.Sling
+}
+
+// NewPlaceService returns a new PlaceService that sends apiKey in the
+// Authorization header.
+func NewPlaceService(httpClient *http.Client, apiKey string) *PlaceService {
+	return &PlaceService{
+		sling: sling.New().Client(httpClient).Base(placesBaseURL).Set("Authorization", apiKey),
+	}
+}
+
+// Place is a v3 place. Which fields are filled in depends on the fields
+// asked for, by default foursquare sends the core fields.
+type Place struct {
+	FsqID         string          `json:"fsq_id"`
+	Name          string          `json:"name"`
+	Geocodes      Geocodes        `json:"geocodes"`
+	Location      PlaceLocation   `json:"location"`
+	Categories    []PlaceCategory `json:"categories"`
+	Chains        []PlaceChain    `json:"chains"`
+	Distance      int             `json:"distance"`
+	Link          string          `json:"link"`
+	TimeZone      string          `json:"timezone"`
+	Description   string          `json:"description"`
+	Tel           string          `json:"tel"`
+	Email         string          `json:"email"`
+	Website       string          `json:"website"`
+	SocialMedia   SocialMedia     `json:"social_media"`
+	Verified      bool            `json:"verified"`
+	Hours         PlaceHours      `json:"hours"`
+	Rating        float64         `json:"rating"`
+	Popularity    float64         `json:"popularity"`
+	Price         int             `json:"price"`
+	Stats         PlaceStats      `json:"stats"`
+	Photos        []PlacePhoto    `json:"photos"`
+	Tips          []PlaceTip      `json:"tips"`
+	DateClosed    string          `json:"date_closed"`
+	StoreID       string          `json:"store_id"`
+	RelatedPlaces Omitted         `json:"related_places"`
+	Features      Omitted         `json:"features"`
+}
+
+// Geocodes are the coordinates of a Place. Main is where the place is
+// shown on a map and Roof is the center of the building.
+type Geocodes struct {
+	Main      Geocode `json:"main"`
+	Roof      Geocode `json:"roof"`
+	DropOff   Geocode `json:"drop_off"`
+	FrontDoor Geocode `json:"front_door"`
+	Road      Geocode `json:"road"`
+}
+
+// Geocode is a single coordinate in Geocodes.
+type Geocode struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+// PlaceLocation is the address of a Place.
+type PlaceLocation struct {
+	Address          string `json:"address"`
+	AddressExtended  string `json:"address_extended"`
+	CrossStreet      string `json:"cross_street"`
+	Locality         string `json:"locality"`
+	Region           string `json:"region"`
+	Postcode         string `json:"postcode"`
+	Country          string `json:"country"`
+	FormattedAddress string `json:"formatted_address"`
+	CensusBlock      string `json:"census_block"`
+	DMA              string `json:"dma"`
+}
+
+// PlaceCategory is a v3 category. The ids are numbers unlike v2.
+type PlaceCategory struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+	Icon Icon   `json:"icon"`
+}
+
+// PlaceChain is a chain a Place belongs to.
+type PlaceChain struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// SocialMedia are the accounts of a Place.
+type SocialMedia struct {
+	FacebookID string `json:"facebook_id"`
+	Instagram  string `json:"instagram"`
+	Twitter    string `json:"twitter"`
+}
+
+// PlaceHours are the opening hours of a Place.
+type PlaceHours struct {
+	Display        string            `json:"display"`
+	IsLocalHoliday bool              `json:"is_local_holiday"`
+	OpenNow        bool              `json:"open_now"`
+	Regular        []PlaceHoursRange `json:"regular"`
+}
+
+// PlaceHoursRange is when a Place opens and closes on a day. Day is 1 for
+// Monday through 7 for Sunday.
+type PlaceHoursRange struct {
+	Day   int    `json:"day"`
+	Open  string `json:"open"`
+	Close string `json:"close"`
+}
+
+// PlaceStats are the counts for a Place.
+type PlaceStats struct {
+	TotalPhotos  int `json:"total_photos"`
+	TotalRatings int `json:"total_ratings"`
+	TotalTips    int `json:"total_tips"`
+}
+
+// PlacePhoto is a v3 photo. The url is Prefix + size + Suffix like v2.
+type PlacePhoto struct {
+	ID              string    `json:"id"`
+	CreatedAt       time.Time `json:"created_at"`
+	Prefix          string    `json:"prefix"`
+	Suffix          string    `json:"suffix"`
+	Width           int       `json:"width"`
+	Height          int       `json:"height"`
+	Classifications []string  `json:"classifications"`
+}
+
+// PlaceTip is a v3 tip.
+type PlaceTip struct {
+	ID            string    `json:"id"`
+	CreatedAt     time.Time `json:"created_at"`
+	Text          string    `json:"text"`
+	URL           string    `json:"url"`
+	Lang          string    `json:"lang"`
+	AgreeCount    int       `json:"agree_count"`
+	DisagreeCount int       `json:"disagree_count"`
+}
+
+// PlaceField are the fields of a Place that can be asked for.
+type PlaceField string
+
+// Options for PlaceField
+const (
+	PlaceFieldFsqID         PlaceField = "fsq_id"
+	PlaceFieldName          PlaceField = "name"
+	PlaceFieldGeocodes      PlaceField = "geocodes"
+	PlaceFieldLocation      PlaceField = "location"
+	PlaceFieldCategories    PlaceField = "categories"
+	PlaceFieldChains        PlaceField = "chains"
+	PlaceFieldDistance      PlaceField = "distance"
+	PlaceFieldLink          PlaceField = "link"
+	PlaceFieldTimeZone      PlaceField = "timezone"
+	PlaceFieldDescription   PlaceField = "description"
+	PlaceFieldTel           PlaceField = "tel"
+	PlaceFieldEmail         PlaceField = "email"
+	PlaceFieldWebsite       PlaceField = "website"
+	PlaceFieldSocialMedia   PlaceField = "social_media"
+	PlaceFieldVerified      PlaceField = "verified"
+	PlaceFieldHours         PlaceField = "hours"
+	PlaceFieldRating        PlaceField = "rating"
+	PlaceFieldPopularity    PlaceField = "popularity"
+	PlaceFieldPrice         PlaceField = "price"
+	PlaceFieldStats         PlaceField = "stats"
+	PlaceFieldPhotos        PlaceField = "photos"
+	PlaceFieldTips          PlaceField = "tips"
+	PlaceFieldDateClosed    PlaceField = "date_closed"
+	PlaceFieldStoreID       PlaceField = "store_id"
+	PlaceFieldRelatedPlaces PlaceField = "related_places"
+	PlaceFieldFeatures      PlaceField = "features"
+)
+
+// PlaceSort is the sort options on PlaceService.Search
+type PlaceSort string
+
+// Options for PlaceSort
+const (
+	SortPlaceRelevance  PlaceSort = "RELEVANCE"
+	SortPlaceRating     PlaceSort = "RATING"
+	SortPlaceDistance   PlaceSort = "DISTANCE"
+	SortPlacePopularity PlaceSort = "POPULARITY"
+)
+
+// PlaceSearchParams are the parameters for PlaceService.Search. Set Cursor
+// from NextCursor to get the next page.
+type PlaceSearchParams struct {
+	Query         string       `url:"query,omitempty"`
+	LatLong       string       `url:"ll,omitempty"`
+	Radius        int          `url:"radius,omitempty"`
+	Categories    []string     `url:"categories,comma,omitempty"`
+	Chains        []string     `url:"chains,comma,omitempty"`
+	ExcludeChains []string     `url:"exclude_chains,comma,omitempty"`
+	Fields        []PlaceField `url:"fields,comma,omitempty"`
+	MinPrice      int          `url:"min_price,omitempty"`
+	MaxPrice      int          `url:"max_price,omitempty"`
+	OpenAt        string       `url:"open_at,omitempty"`
+	OpenNow       bool         `url:"open_now,omitempty"`
+	Ne            string       `url:"ne,omitempty"`
+	Sw            string       `url:"sw,omitempty"`
+	Near          string       `url:"near,omitempty"`
+	Sort          PlaceSort    `url:"sort,omitempty"`
+	Limit         int          `url:"limit,omitempty"`
+	Cursor        string       `url:"cursor,omitempty"`
+}
+
+type placeSearchResp struct {
+	Results []Place `json:"results"`
+}
+
+// Search returns places matching the query near a location.
+// https://location.foursquare.com/developer/reference/place-search
+func (s *PlaceService) Search(params *PlaceSearchParams) ([]Place, *http.Response, error) {
+	places := new(placeSearchResp)
+	placesErr := new(placesError)
+
+	resp, err := s.sling.New().Get("places/search").QueryStruct(params).Receive(places, placesErr)
+	return places.Results, resp, relevantPlacesError(err, resp, *placesErr)
+}
+
+// PlaceDetailsParams are the parameters for PlaceService.Details
+type PlaceDetailsParams struct {
+	FsqID  string       `url:"-"`
+	Fields []PlaceField `url:"fields,comma,omitempty"`
+}
+
+// Details gets the data for a place.
+// https://location.foursquare.com/developer/reference/place-details
+func (s *PlaceService) Details(params *PlaceDetailsParams) (*Place, *http.Response, error) {
+	place := new(Place)
+	placesErr := new(placesError)
+
+	resp, err := s.sling.New().Get("places/"+params.FsqID).QueryStruct(params).Receive(place, placesErr)
+	return place, resp, relevantPlacesError(err, resp, *placesErr)
+}
+
+// PlacePhotoSort is the sort options on PlaceService.Photos
+type PlacePhotoSort string
+
+// Options for PlacePhotoSort
+const (
+	SortPlacePhotoPopular PlacePhotoSort = "POPULAR"
+	SortPlacePhotoNewest  PlacePhotoSort = "NEWEST"
+)
+
+// PlacePhotosParams are the parameters for PlaceService.Photos
+type PlacePhotosParams struct {
+	FsqID           string         `url:"-"`
+	Limit           int            `url:"limit,omitempty"`
+	Sort            PlacePhotoSort `url:"sort,omitempty"`
+	Classifications []string       `url:"classifications,comma,omitempty"`
+}
+
+// Photos returns photos of a place.
+// https://location.foursquare.com/developer/reference/place-photos
+func (s *PlaceService) Photos(params *PlacePhotosParams) ([]PlacePhoto, *http.Response, error) {
+	var photos []PlacePhoto
+	placesErr := new(placesError)
+
+	resp, err := s.sling.New().Get("places/"+params.FsqID+"/photos").QueryStruct(params).Receive(&photos, placesErr)
+	return photos, resp, relevantPlacesError(err, resp, *placesErr)
+}
+
+// PlaceTipSort is the sort options on PlaceService.Tips
+type PlaceTipSort string
+
+// Options for PlaceTipSort
+const (
+	SortPlaceTipPopular PlaceTipSort = "POPULAR"
+	SortPlaceTipNewest  PlaceTipSort = "NEWEST"
+)
+
+// PlaceTipsParams are the parameters for PlaceService.Tips
+type PlaceTipsParams struct {
+	FsqID string       `url:"-"`
+	Limit int          `url:"limit,omitempty"`
+	Sort  PlaceTipSort `url:"sort,omitempty"`
+}
+
+// Tips returns tips for a place.
+// https://location.foursquare.com/developer/reference/place-tips
+func (s *PlaceService) Tips(params *PlaceTipsParams) ([]PlaceTip, *http.Response, error) {
+	var tips []PlaceTip
+	placesErr := new(placesError)
+
+	resp, err := s.sling.New().Get("places/"+params.FsqID+"/tips").QueryStruct(params).Receive(&tips, placesErr)
+	return tips, resp, relevantPlacesError(err, resp, *placesErr)
+}
+
+// AutocompleteType are the kinds of results PlaceService.Autocomplete
+// can return.
+type AutocompleteType string
+
+// Options for AutocompleteType
+const (
+	AutocompletePlace   AutocompleteType = "place"
+	AutocompleteAddress AutocompleteType = "address"
+	AutocompleteSearch  AutocompleteType = "search"
+	AutocompleteGeo     AutocompleteType = "geo"
+)
+
+// PlaceAutocompleteParams are the parameters for PlaceService.Autocomplete.
+// Use the same SessionToken for every request a user makes while typing.
+type PlaceAutocompleteParams struct {
+	Query        string             `url:"query"`
+	LatLong      string             `url:"ll,omitempty"`
+	Radius       int                `url:"radius,omitempty"`
+	Types        []AutocompleteType `url:"types,comma,omitempty"`
+	Bias         AutocompleteType   `url:"bias,omitempty"`
+	Limit        int                `url:"limit,omitempty"`
+	SessionToken string             `url:"session_token,omitempty"`
+}
+
+// AutocompleteResult is one result from PlaceService.Autocomplete. Place
+// is set when Type is place.
+type AutocompleteResult struct {
+	Type    AutocompleteType `json:"type"`
+	Text    AutocompleteText `json:"text"`
+	Link    string           `json:"link"`
+	Place   *Place           `json:"place"`
+	Address Omitted          `json:"address"`
+	Search  Omitted          `json:"search"`
+	Geo     Omitted          `json:"geo"`
+}
+
+// AutocompleteText is the text to show for an AutocompleteResult.
+// Highlight marks the parts of Primary that match the query.
+type AutocompleteText struct {
+	Primary   string          `json:"primary"`
+	Secondary string          `json:"secondary"`
+	Highlight []TextHighlight `json:"highlight"`
+}
+
+// TextHighlight is where a match is in AutocompleteText.
+type TextHighlight struct {
+	Start  int `json:"start"`
+	Length int `json:"length"`
+}
+
+type placeAutocompleteResp struct {
+	Results []AutocompleteResult `json:"results"`
+}
+
+// Autocomplete returns suggestions for a partial query.
+// https://location.foursquare.com/developer/reference/autocomplete
+func (s *PlaceService) Autocomplete(params *PlaceAutocompleteParams) ([]AutocompleteResult, *http.Response, error) {
+	results := new(placeAutocompleteResp)
+	placesErr := new(placesError)
+
+	resp, err := s.sling.New().Get("autocomplete").QueryStruct(params).Receive(results, placesErr)
+	return results.Results, resp, relevantPlacesError(err, resp, *placesErr)
+}
+
+// NextCursor is a helper function to get the cursor of the next page from
+// the Link header of a v3 response. It is empty on the last page.
+func NextCursor(resp *http.Response) string {
+	if resp == nil {
+		return ""
+	}
+
+	for _, link := range strings.Split(resp.Header.Get("Link"), ",") {
+		parts := strings.Split(link, ";")
+		if len(parts) < 2 {
+			continue
+		}
+
+		next := false
+		for _, p := range parts[1:] {
+			if strings.TrimSpace(p) == `rel="next"` {
+				next = true
+			}
+		}
+		if !next {
+			continue
+		}
+
+		u, err := url.Parse(strings.Trim(strings.TrimSpace(parts[0]), "<>"))
+		if err != nil {
+			return ""
+		}
+		return u.Query().Get("cursor")
+	}
+
+	return ""
+}
+
+// placesError is the body v3 sends with an error status.
+type placesError struct {
+	Message string `json:"message"`
+}
+
+// relevantPlacesError turns a v3 error into an APIError so it can be
+// handled the same as a v2 one. The ErrorType is picked from the status.
+func relevantPlacesError(httpError error, resp *http.Response, e placesError) error {
+	if httpError != nil {
+		return httpError
+	}
+
+	if resp == nil || (resp.StatusCode >= 200 && resp.StatusCode <= 299) {
+		return nil
+	}
+
+	var errorType string
+	switch code := resp.StatusCode; {
+	case code == http.StatusBadRequest:
+		errorType = "param_error"
+	case code == http.StatusUnauthorized:
+		errorType = "invalid_auth"
+	case code == http.StatusForbidden:
+		errorType = "not_authorized"
+	case code == http.StatusNotFound:
+		errorType = "endpoint_error"
+	case code == http.StatusTooManyRequests:
+		errorType = "rate_limit_exceeded"
+	case code >= 500:
+		errorType = "server_error"
+	default:
+		errorType = "other"
+	}
+
+	return &APIError{
+		Meta: Meta{
+			Code:        resp.StatusCode,
+			ErrorType:   errorType,
+			ErrorDetail: e.Message,
+		},
+	}
+}
@@ -0,0 +1,356 @@
+package foursquarego
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// VenueSource finds venues. VenueService is a VenueSource for the v2 API
+// and PlaceService.VenueSource returns one backed by the Places API v3, so
+// code written against Venue can move between them.
+type VenueSource interface {
+	Search(params *VenueSearchParams) ([]Venue, *http.Response, error)
+	Details(id string) (*Venue, *http.Response, error)
+}
+
+var _ VenueSource = (*VenueService)(nil)
+
+// CategoryMap maps v2 category ids to v3 category ids.
+type CategoryMap map[string]int
+
+// defaultCategoryMap is used by the converters between Venue and Place that
+// don't take a CategoryMap. It is never written to so conversions can run
+// at once.
+var defaultCategoryMap = CategoryMap{
+	"4d4b7104d754a06370d81259": 10000, // Arts & Entertainment
+	"4bf58dd8d48988d17f941735": 10024, // Movie Theater
+	"4bf58dd8d48988d1e5931735": 10039, // Music Venue
+	"4d4b7105d754a06374d81259": 13000, // Food
+	"4bf58dd8d48988d16a941735": 13002, // Bakery
+	"4bf58dd8d48988d116941735": 13003, // Bar
+	"56aa371ce4b08b9a8d57356c": 13006, // Beer Bar
+	"4bf58dd8d48988d11e941735": 13009, // Cocktail Bar
+	"50327c8591d4c4b30a586d5d": 13029, // Brewery
+	"4bf58dd8d48988d16d941735": 13034, // Café
+	"4bf58dd8d48988d1e0931735": 13035, // Coffee Shop
+	"4bf58dd8d48988d1ca941735": 13064, // Pizza Place
+	"4bf58dd8d48988d14e941735": 13068, // American Restaurant
+	"4d4b7105d754a06377d81259": 16000, // Outdoors & Recreation
+	"4bf58dd8d48988d163941735": 16032, // Park
+	"4d4b7105d754a06378d81259": 17000, // Shop & Service
+	"4d4b7105d754a06379d81259": 19000, // Travel & Transport
+	"4bf58dd8d48988d1fa931735": 19014, // Hotel
+}
+
+// DefaultCategoryMap returns a copy of the map used when no CategoryMap is
+// given. It only has common categories, add the ones your app uses to the
+// copy and convert with its methods.
+func DefaultCategoryMap() CategoryMap {
+	m := make(CategoryMap, len(defaultCategoryMap))
+	for v2, v3 := range defaultCategoryMap {
+		m[v2] = v3
+	}
+	return m
+}
+
+// V3 returns the v3 id for a v2 category id.
+func (m CategoryMap) V3(id string) (int, bool) {
+	v3, ok := m[id]
+	return v3, ok
+}
+
+// V2 returns the v2 id for a v3 category id.
+func (m CategoryMap) V2(id int) (string, bool) {
+	for v2, v3 := range m {
+		if v3 == id {
+			return v2, true
+		}
+	}
+	return "", false
+}
+
+// Venue converts a v3 place to the v2 model. Category ids missing from
+// DefaultCategoryMap are left empty.
+func (p Place) Venue() Venue {
+	return defaultCategoryMap.Venue(p)
+}
+
+// Venue converts a v3 place to the v2 model with the categories in m.
+// Category ids missing from m are left empty.
+func (m CategoryMap) Venue(p Place) Venue {
+	v := Venue{
+		ID:   p.FsqID,
+		Name: p.Name,
+		Contact: Contact{
+			Phone:          digits(p.Tel),
+			FormattedPhone: p.Tel,
+			Twitter:        p.SocialMedia.Twitter,
+			Facebook:       p.SocialMedia.FacebookID,
+			Instagram:      p.SocialMedia.Instagram,
+		},
+		Location: Location{
+			Address:          p.Location.Address,
+			CrossStreet:      p.Location.CrossStreet,
+			Lat:              p.Geocodes.Main.Latitude,
+			Lng:              p.Geocodes.Main.Longitude,
+			PostalCode:       p.Location.Postcode,
+			Cc:               p.Location.Country,
+			City:             p.Location.Locality,
+			State:            p.Location.Region,
+			FormattedAddress: p.Location.formattedAddress(),
+			Distance:         p.Distance,
+		},
+		Verified:      p.Verified,
+		Stats:         Stats{TipCount: p.Stats.TotalTips},
+		URL:           p.Website,
+		Price:         Price{Tier: p.Price},
+		Rating:        p.Rating,
+		RatingSignals: p.Stats.TotalRatings,
+		Description:   p.Description,
+		StoreID:       p.StoreID,
+		TimeZone:      p.TimeZone,
+		Hours: Hours{
+			Status:         p.Hours.Display,
+			IsOpen:         p.Hours.OpenNow,
+			IsLocalHoliday: p.Hours.IsLocalHoliday,
+		},
+	}
+
+	for i, c := range p.Categories {
+		v.Categories = append(v.Categories, m.Category(c, i == 0))
+	}
+
+	v.Photos.Count = p.Stats.TotalPhotos
+	if len(p.Photos) > 0 {
+		group := PhotoGrouping{Group: Group{Type: "venue", Count: len(p.Photos)}}
+		for _, photo := range p.Photos {
+			group.Items = append(group.Items, photo.Photo())
+		}
+		v.Photos.Groups = []PhotoGrouping{group}
+		v.BestPhoto = group.Items[0]
+	}
+
+	return v
+}
+
+// Place converts a v2 venue to the v3 model. The primary category is put
+// first as v3 has no primary flag.
+func (v Venue) Place() Place {
+	return defaultCategoryMap.Place(v)
+}
+
+// Place converts a v2 venue to the v3 model with the categories in m.
+func (m CategoryMap) Place(v Venue) Place {
+	p := Place{
+		FsqID: v.ID,
+		Name:  v.Name,
+		Geocodes: Geocodes{
+			Main: Geocode{Latitude: v.Location.Lat, Longitude: v.Location.Lng},
+		},
+		Location: PlaceLocation{
+			Address:          v.Location.Address,
+			CrossStreet:      v.Location.CrossStreet,
+			Locality:         v.Location.City,
+			Region:           v.Location.State,
+			Postcode:         v.Location.PostalCode,
+			Country:          v.Location.Cc,
+			FormattedAddress: strings.Join(v.Location.FormattedAddress, ", "),
+		},
+		Distance:    v.Location.Distance,
+		TimeZone:    v.TimeZone,
+		Description: v.Description,
+		Tel:         v.Contact.FormattedPhone,
+		Website:     v.URL,
+		SocialMedia: SocialMedia{
+			FacebookID: v.Contact.Facebook,
+			Instagram:  v.Contact.Instagram,
+			Twitter:    v.Contact.Twitter,
+		},
+		Verified: v.Verified,
+		Hours: PlaceHours{
+			Display:        v.Hours.Status,
+			IsLocalHoliday: v.Hours.IsLocalHoliday,
+			OpenNow:        v.Hours.IsOpen,
+		},
+		Rating: v.Rating,
+		Price:  v.Price.Tier,
+		Stats: PlaceStats{
+			TotalPhotos:  v.Photos.Count,
+			TotalRatings: v.RatingSignals,
+			TotalTips:    v.Stats.TipCount,
+		},
+		StoreID: v.StoreID,
+	}
+	if p.Tel == "" {
+		p.Tel = v.Contact.Phone
+	}
+
+	for _, c := range v.Categories {
+		if c.Primary {
+			p.Categories = append([]PlaceCategory{m.PlaceCategory(c)}, p.Categories...)
+		} else {
+			p.Categories = append(p.Categories, m.PlaceCategory(c))
+		}
+	}
+
+	for _, g := range v.Photos.Groups {
+		for _, photo := range g.Items {
+			p.Photos = append(p.Photos, photo.PlacePhoto())
+		}
+	}
+
+	return p
+}
+
+// Category converts a v3 category to the v2 model.
+func (c PlaceCategory) Category(primary bool) Category {
+	return defaultCategoryMap.Category(c, primary)
+}
+
+// Category converts a v3 category to the v2 model with the id in m.
+func (m CategoryMap) Category(c PlaceCategory, primary bool) Category {
+	id, _ := m.V2(c.ID)
+	return Category{
+		ID:        id,
+		Name:      c.Name,
+		ShortName: c.Name,
+		Icon:      c.Icon,
+		Primary:   primary,
+	}
+}
+
+// PlaceCategory converts a v2 category to the v3 model. The ID is 0 when
+// it is missing from DefaultCategoryMap.
+func (c Category) PlaceCategory() PlaceCategory {
+	return defaultCategoryMap.PlaceCategory(c)
+}
+
+// PlaceCategory converts a v2 category to the v3 model with the id in m.
+// The ID is 0 when it is missing from m.
+func (m CategoryMap) PlaceCategory(c Category) PlaceCategory {
+	id, _ := m.V3(c.ID)
+	return PlaceCategory{
+		ID:   id,
+		Name: c.Name,
+		Icon: c.Icon,
+	}
+}
+
+// Photo converts a v3 photo to the v2 model.
+func (p PlacePhoto) Photo() Photo {
+	return Photo{
+		ID:        p.ID,
+		CreatedAt: Timestamp{p.CreatedAt},
+		Prefix:    p.Prefix,
+		Suffix:    p.Suffix,
+		Width:     p.Width,
+		Height:    p.Height,
+	}
+}
+
+// PlacePhoto converts a v2 photo to the v3 model.
+func (p Photo) PlacePhoto() PlacePhoto {
+	return PlacePhoto{
+		ID:        p.ID,
+		CreatedAt: p.CreatedAt.Time,
+		Prefix:    p.Prefix,
+		Suffix:    p.Suffix,
+		Width:     p.Width,
+		Height:    p.Height,
+	}
+}
+
+// formattedAddress splits the v3 address into the lines v2 uses, the
+// street first and then the rest.
+func (l PlaceLocation) formattedAddress() []string {
+	if l.FormattedAddress == "" {
+		return nil
+	}
+
+	street := l.Address
+	if street != "" && l.CrossStreet != "" {
+		street += " (" + l.CrossStreet + ")"
+	}
+	if street != "" && strings.HasPrefix(l.FormattedAddress, street+", ") {
+		return []string{street, strings.TrimPrefix(l.FormattedAddress, street+", ")}
+	}
+	return []string{l.FormattedAddress}
+}
+
+func digits(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, s)
+}
+
+// VenueSource returns a VenueSource that answers with the Places API v3.
+// Fields are asked for on every request, leave them out for the defaults.
+func (s *PlaceService) VenueSource(fields ...PlaceField) *PlaceVenueSource {
+	return &PlaceVenueSource{places: s, fields: fields}
+}
+
+// PlaceVenueSource is a VenueSource backed by the Places API v3.
+type PlaceVenueSource struct {
+	places *PlaceService
+	fields []PlaceField
+
+	// Categories converts category ids, DefaultCategoryMap when nil.
+	Categories CategoryMap
+}
+
+var _ VenueSource = (*PlaceVenueSource)(nil)
+
+func (s *PlaceVenueSource) categories() CategoryMap {
+	if s.Categories == nil {
+		return defaultCategoryMap
+	}
+	return s.Categories
+}
+
+// Search maps the v2 parameters that v3 supports. Intent and the other
+// v2 only parameters are ignored.
+func (s *PlaceVenueSource) Search(params *VenueSearchParams) ([]Venue, *http.Response, error) {
+	p := &PlaceSearchParams{
+		Query:   params.Query,
+		LatLong: params.LatLong,
+		Near:    params.Near,
+		Radius:  params.Radius,
+		Sw:      params.Sw,
+		Ne:      params.Ne,
+		Limit:   params.Limit,
+		Fields:  s.fields,
+	}
+	for _, id := range params.CategoryID {
+		v3, ok := s.categories().V3(id)
+		if !ok {
+			return nil, nil, fmt.Errorf("foursquarego: no v3 category for %s", id)
+		}
+		p.Categories = append(p.Categories, fmt.Sprint(v3))
+	}
+
+	places, resp, err := s.places.Search(p)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	venues := make([]Venue, len(places))
+	for i, place := range places {
+		venues[i] = s.categories().Venue(place)
+	}
+	return venues, resp, nil
+}
+
+// Details gets the place with the fsq_id and converts it to a Venue.
+func (s *PlaceVenueSource) Details(id string) (*Venue, *http.Response, error) {
+	place, resp, err := s.places.Details(&PlaceDetailsParams{FsqID: id, Fields: s.fields})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	venue := s.categories().Venue(*place)
+	return &venue, resp, nil
+}
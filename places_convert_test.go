@@ -0,0 +1,170 @@
+package foursquarego
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlace_Venue(t *testing.T) {
+	b, err := getTestFile("./json/places/details.json")
+	assert.Nil(t, err)
+
+	var place Place
+	err = json.Unmarshal(b, &place)
+	assert.Nil(t, err)
+
+	venue := place.Venue()
+	assert.Equal(t, "5414d0a6498ea3d31a3c64cf", venue.ID)
+	assert.Equal(t, "Threes Brewing", venue.Name)
+	assert.Equal(t, 40.67979901271337, venue.Location.Lat)
+	assert.Equal(t, -73.98215935484912, venue.Location.Lng)
+	assert.Equal(t, "Brooklyn", venue.Location.City)
+	assert.Equal(t, "NY", venue.Location.State)
+	assert.Equal(t, "11217", venue.Location.PostalCode)
+	assert.Equal(t, "US", venue.Location.Cc)
+	assert.Equal(t, []string{"333 Douglass St (at 4th Ave)", "Brooklyn, NY 11217"}, venue.Location.FormattedAddress)
+	assert.Equal(t, "7185222110", venue.Contact.Phone)
+	assert.Equal(t, "(718) 522-2110", venue.Contact.FormattedPhone)
+	assert.Equal(t, "threesbrewing", venue.Contact.Twitter)
+	assert.Equal(t, "http://www.threesbrewing.com", venue.URL)
+	assert.Equal(t, 2, venue.Price.Tier)
+	assert.Equal(t, 108, venue.Stats.TipCount)
+	assert.Equal(t, "America/New_York", venue.TimeZone)
+
+	assert.Len(t, venue.Categories, 2)
+	assert.Equal(t, "50327c8591d4c4b30a586d5d", venue.Categories[0].ID)
+	assert.Equal(t, "Brewery", venue.Categories[0].Name)
+	assert.Equal(t, true, venue.Categories[0].Primary)
+	assert.Equal(t, "56aa371ce4b08b9a8d57356c", venue.Categories[1].ID)
+	assert.Equal(t, false, venue.Categories[1].Primary)
+
+	assert.Equal(t, 1081, venue.Photos.Count)
+	assert.Equal(t, "https://fastly.4sqi.net/img/general/", venue.Photos.Groups[0].Items[0].Prefix)
+	assert.Equal(t, "/68150_abc.jpg", venue.BestPhoto.Suffix)
+	assert.Equal(t, int64(1527620785), venue.BestPhoto.CreatedAt.Unix())
+}
+
+func TestVenue_Place(t *testing.T) {
+	b, err := getTestFile("./json/venues/details.json")
+	assert.Nil(t, err)
+
+	var r Response
+	err = json.Unmarshal(b, &r)
+	assert.Nil(t, err)
+	venue := new(venueResp)
+	err = json.Unmarshal(r.Response, venue)
+	assert.Nil(t, err)
+
+	place := venue.Venue.Place()
+	assert.Equal(t, "5414d0a6498ea3d31a3c64cf", place.FsqID)
+	assert.Equal(t, Geocode{Latitude: 40.67979901271337, Longitude: -73.98215935484912}, place.Geocodes.Main)
+	assert.Equal(t, "333 Douglass St (at 4th Ave), Brooklyn, NY 11217", place.Location.FormattedAddress)
+	assert.Equal(t, "Brooklyn", place.Location.Locality)
+	assert.Equal(t, "(718) 522-2110", place.Tel)
+	assert.Equal(t, "1494258594141562", place.SocialMedia.FacebookID)
+	assert.Equal(t, 9.4, place.Rating)
+	assert.Equal(t, PlaceStats{TotalPhotos: 735, TotalRatings: 1309, TotalTips: 165}, place.Stats)
+
+	assert.Equal(t, 13029, place.Categories[0].ID)
+	assert.Equal(t, "Brewery", place.Categories[0].Name)
+	assert.Equal(t, 13003, place.Categories[1].ID)
+
+	assert.Equal(t, "549ecb0f11d2ed4887ba35ab", place.Photos[0].ID)
+	assert.Equal(t, "https://igx.4sqi.net/img/general/", place.Photos[0].Prefix)
+	assert.Equal(t, int64(1419692815), place.Photos[0].CreatedAt.Unix())
+
+	back := place.Venue()
+	assert.Equal(t, venue.Venue.Location.FormattedAddress, back.Location.FormattedAddress)
+	assert.Equal(t, venue.Venue.Categories[0].ID, back.Categories[0].ID)
+}
+
+func TestCategoryMap(t *testing.T) {
+	id, ok := DefaultCategoryMap().V3("4bf58dd8d48988d1e0931735")
+	assert.True(t, ok)
+	assert.Equal(t, 13035, id)
+
+	v2, ok := DefaultCategoryMap().V2(13035)
+	assert.True(t, ok)
+	assert.Equal(t, "4bf58dd8d48988d1e0931735", v2)
+
+	_, ok = DefaultCategoryMap().V2(99999)
+	assert.False(t, ok)
+	assert.Equal(t, 0, Category{ID: "unknown"}.PlaceCategory().ID)
+
+	m := DefaultCategoryMap()
+	m["unknown"] = 12345
+	assert.Equal(t, 12345, m.PlaceCategory(Category{ID: "unknown"}).ID)
+	assert.Equal(t, "unknown", m.Category(PlaceCategory{ID: 12345}, true).ID)
+	assert.Equal(t, 0, Category{ID: "unknown"}.PlaceCategory().ID)
+	_, ok = DefaultCategoryMap().V3("unknown")
+	assert.False(t, ok)
+}
+
+func TestPlaceService_VenueSource(t *testing.T) {
+	const filePath = "./json/places/search.json"
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/v3/places/search", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertPlacesQuery(t, map[string]string{
+			"query":      "brewery",
+			"ll":         "40.68,-73.98",
+			"categories": "13029",
+			"limit":      "2",
+			"fields":     "fsq_id,name,geocodes,location,categories",
+		}, r)
+
+		b, err := getTestFile(filePath)
+		if err != nil {
+			t.Fatalf("Failed to open testfile %s", filePath)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+	})
+
+	var source VenueSource = NewPlaceService(httpClient, apiKey).VenueSource(
+		PlaceFieldFsqID,
+		PlaceFieldName,
+		PlaceFieldGeocodes,
+		PlaceFieldLocation,
+		PlaceFieldCategories,
+	)
+	venues, _, err := source.Search(&VenueSearchParams{
+		LatLong:    "40.68,-73.98",
+		Query:      "brewery",
+		Intent:     IntentBrowse,
+		CategoryID: []string{"50327c8591d4c4b30a586d5d"},
+		Limit:      2,
+	})
+	assert.Nil(t, err)
+
+	assert.Len(t, venues, 2)
+	assert.Equal(t, "5414d0a6498ea3d31a3c64cf", venues[0].ID)
+	assert.Equal(t, 112, venues[0].Location.Distance)
+	assert.Equal(t, "Brewery", venues[1].Categories[0].Name)
+
+	_, _, err = source.Search(&VenueSearchParams{CategoryID: []string{"unknown"}})
+	assert.NotNil(t, err)
+
+	custom := NewPlaceService(httpClient, apiKey).VenueSource(
+		PlaceFieldFsqID,
+		PlaceFieldName,
+		PlaceFieldGeocodes,
+		PlaceFieldLocation,
+		PlaceFieldCategories,
+	)
+	custom.Categories = CategoryMap{"brewery": 13029}
+	venues, _, err = custom.Search(&VenueSearchParams{
+		LatLong:    "40.68,-73.98",
+		Query:      "brewery",
+		CategoryID: []string{"brewery"},
+		Limit:      2,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "brewery", venues[0].Categories[0].ID)
+}
@@ -0,0 +1,256 @@
+package foursquarego
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const apiKey = "fsq3key"
+
+func assertPlacesQuery(t *testing.T, expected map[string]string, req *http.Request) {
+	assert.Equal(t, apiKey, req.Header.Get("Authorization"))
+
+	expectedValues := url.Values{}
+	for key, value := range expected {
+		expectedValues.Add(key, value)
+	}
+	assert.Equal(t, expectedValues, req.URL.Query())
+}
+
+func TestPlaceService_Search(t *testing.T) {
+	const filePath = "./json/places/search.json"
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/v3/places/search", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertPlacesQuery(t, map[string]string{
+			"query":  "brewery",
+			"ll":     "40.68,-73.98",
+			"fields": "fsq_id,name,geocodes,location,categories,distance",
+			"sort":   "DISTANCE",
+			"limit":  "2",
+		}, r)
+
+		b, err := getTestFile(filePath)
+		if err != nil {
+			t.Fatalf("Failed to open testfile %s", filePath)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Link", `<https://api.foursquare.com/v3/places/search?query=brewery&ll=40.68%2C-73.98&limit=2&cursor=c3I6MiNsaTox>; rel="next"`)
+		w.Write(b)
+	})
+
+	client := NewPlaceService(httpClient, apiKey)
+	places, resp, err := client.Search(&PlaceSearchParams{
+		Query:   "brewery",
+		LatLong: "40.68,-73.98",
+		Fields: []PlaceField{
+			PlaceFieldFsqID,
+			PlaceFieldName,
+			PlaceFieldGeocodes,
+			PlaceFieldLocation,
+			PlaceFieldCategories,
+			PlaceFieldDistance,
+		},
+		Sort:  SortPlaceDistance,
+		Limit: 2,
+	})
+	assert.Nil(t, err)
+
+	assert.Len(t, places, 2)
+	assert.Equal(t, "5414d0a6498ea3d31a3c64cf", places[0].FsqID)
+	assert.Equal(t, "Threes Brewing", places[0].Name)
+	assert.Equal(t, 112, places[0].Distance)
+	assert.Equal(t, 40.67979901271337, places[0].Geocodes.Main.Latitude)
+	assert.Equal(t, -73.98215935484912, places[0].Geocodes.Main.Longitude)
+	assert.Equal(t, "333 Douglass St", places[0].Location.Address)
+	assert.Equal(t, "Brooklyn", places[0].Location.Locality)
+	assert.Equal(t, 13029, places[0].Categories[0].ID)
+	assert.Equal(t, "America/New_York", places[1].TimeZone)
+
+	assert.Equal(t, "c3I6MiNsaTox", NextCursor(resp))
+}
+
+func TestPlaceService_Details(t *testing.T) {
+	const filePath = "./json/places/details.json"
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/v3/places/5414d0a6498ea3d31a3c64cf", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertPlacesQuery(t, map[string]string{}, r)
+
+		b, err := getTestFile(filePath)
+		if err != nil {
+			t.Fatalf("Failed to open testfile %s", filePath)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+	})
+
+	client := NewPlaceService(httpClient, apiKey)
+	place, resp, err := client.Details(&PlaceDetailsParams{FsqID: "5414d0a6498ea3d31a3c64cf"})
+	assert.Nil(t, err)
+
+	assert.Equal(t, "5414d0a6498ea3d31a3c64cf", place.FsqID)
+	assert.Equal(t, "(718) 522-2110", place.Tel)
+	assert.Equal(t, "threesbrewing", place.SocialMedia.Instagram)
+	assert.Equal(t, true, place.Verified)
+	assert.Equal(t, true, place.Hours.OpenNow)
+	assert.Equal(t, PlaceHoursRange{Day: 5, Open: "1400", Close: "+0200"}, place.Hours.Regular[1])
+	assert.Equal(t, 9.1, place.Rating)
+	assert.Equal(t, 2, place.Price)
+	assert.Equal(t, 108, place.Stats.TotalTips)
+	assert.Equal(t, "/68150_abc.jpg", place.Photos[0].Suffix)
+
+	assert.Equal(t, "", NextCursor(resp))
+}
+
+func TestPlaceService_Photos(t *testing.T) {
+	const filePath = "./json/places/photos.json"
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/v3/places/5414d0a6498ea3d31a3c64cf/photos", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertPlacesQuery(t, map[string]string{
+			"limit": "2",
+			"sort":  "NEWEST",
+		}, r)
+
+		b, err := getTestFile(filePath)
+		if err != nil {
+			t.Fatalf("Failed to open testfile %s", filePath)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+	})
+
+	client := NewPlaceService(httpClient, apiKey)
+	photos, _, err := client.Photos(&PlacePhotosParams{
+		FsqID: "5414d0a6498ea3d31a3c64cf",
+		Limit: 2,
+		Sort:  SortPlacePhotoNewest,
+	})
+	assert.Nil(t, err)
+
+	assert.Len(t, photos, 2)
+	assert.Equal(t, "5b0da4b1a6031c002c6c9d2e", photos[0].ID)
+	assert.Equal(t, time.Date(2018, 5, 29, 19, 6, 25, 0, time.UTC), photos[0].CreatedAt)
+	assert.Equal(t, 1440, photos[0].Width)
+	assert.Equal(t, []string{"food"}, photos[1].Classifications)
+}
+
+func TestPlaceService_Tips(t *testing.T) {
+	const filePath = "./json/places/tips.json"
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/v3/places/5414d0a6498ea3d31a3c64cf/tips", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertPlacesQuery(t, map[string]string{
+			"sort": "POPULAR",
+		}, r)
+
+		b, err := getTestFile(filePath)
+		if err != nil {
+			t.Fatalf("Failed to open testfile %s", filePath)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+	})
+
+	client := NewPlaceService(httpClient, apiKey)
+	tips, _, err := client.Tips(&PlaceTipsParams{
+		FsqID: "5414d0a6498ea3d31a3c64cf",
+		Sort:  SortPlaceTipPopular,
+	})
+	assert.Nil(t, err)
+
+	assert.Len(t, tips, 1)
+	assert.Equal(t, "5aff27a1603d2a002c81fac1", tips[0].ID)
+	assert.Equal(t, "The patio out back is the best place in Gowanus on a summer afternoon.", tips[0].Text)
+	assert.Equal(t, 4, tips[0].AgreeCount)
+}
+
+func TestPlaceService_Autocomplete(t *testing.T) {
+	const filePath = "./json/places/autocomplete.json"
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/v3/autocomplete", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertPlacesQuery(t, map[string]string{
+			"query":         "three",
+			"ll":            "40.68,-73.98",
+			"types":         "place,search",
+			"session_token": "a1b2c3",
+		}, r)
+
+		b, err := getTestFile(filePath)
+		if err != nil {
+			t.Fatalf("Failed to open testfile %s", filePath)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+	})
+
+	client := NewPlaceService(httpClient, apiKey)
+	results, _, err := client.Autocomplete(&PlaceAutocompleteParams{
+		Query:        "three",
+		LatLong:      "40.68,-73.98",
+		Types:        []AutocompleteType{AutocompletePlace, AutocompleteSearch},
+		SessionToken: "a1b2c3",
+	})
+	assert.Nil(t, err)
+
+	assert.Len(t, results, 2)
+	assert.Equal(t, AutocompletePlace, results[0].Type)
+	assert.Equal(t, "Threes Brewing", results[0].Text.Primary)
+	assert.Equal(t, TextHighlight{Start: 0, Length: 5}, results[0].Text.Highlight[0])
+	assert.Equal(t, "5414d0a6498ea3d31a3c64cf", results[0].Place.FsqID)
+	assert.Equal(t, AutocompleteSearch, results[1].Type)
+	assert.Nil(t, results[1].Place)
+}
+
+func TestPlaceService_Error(t *testing.T) {
+	const filePath = "./json/places/error.json"
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/v3/places/5414d0a6498ea3d31a3c64cf", func(w http.ResponseWriter, r *http.Request) {
+		b, err := getTestFile(filePath)
+		if err != nil {
+			t.Fatalf("Failed to open testfile %s", filePath)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-RateLimit-Limit", "500")
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write(b)
+	})
+
+	client := NewPlaceService(httpClient, apiKey)
+	_, resp, err := client.Details(&PlaceDetailsParams{FsqID: "5414d0a6498ea3d31a3c64cf"})
+	if assert.IsType(t, &APIError{}, err) {
+		apiErr := err.(*APIError)
+		assert.Equal(t, 401, apiErr.Meta.Code)
+		assert.Equal(t, "invalid_auth", apiErr.Meta.ErrorType)
+		assert.Equal(t, "Invalid request token.", apiErr.Meta.ErrorDetail)
+	}
+
+	rate := ParseRate(resp)
+	assert.Equal(t, 500, rate.Limit)
+	assert.Equal(t, 0, rate.Remaining)
+}
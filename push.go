@@ -0,0 +1,74 @@
+package foursquarego
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+)
+
+// PushHandler is an http.Handler for the Real-time Push API. Foursquare
+// POSTs a checkin with the app's push secret to the push url set up for
+// the app. User pushes also have the user, venue pushes do not, which is
+// how OnUserCheckin and OnVenueCheckin are picked.
+// https://developer.foursquare.com/docs/api/configuration/real-time
+type PushHandler struct {
+	// Secret is the push secret of the app. Requests with a different
+	// secret are rejected.
+	Secret string
+
+	// OnUserCheckin is called when a user who authorized the app checks in.
+	OnUserCheckin func(checkin *Checkin, user *User)
+
+	// OnVenueCheckin is called when someone checks in at a venue the app
+	// manages.
+	OnVenueCheckin func(checkin *Checkin)
+}
+
+// NewPushHandler returns a new PushHandler for the push secret.
+func NewPushHandler(secret string) *PushHandler {
+	return &PushHandler{
+		Secret: secret,
+	}
+}
+
+// ServeHTTP checks the push secret and calls the handler for the checkin.
+func (h *PushHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "invalid form", http.StatusBadRequest)
+		return
+	}
+
+	secret := r.PostForm.Get("secret")
+	if h.Secret == "" || subtle.ConstantTimeCompare([]byte(secret), []byte(h.Secret)) != 1 {
+		http.Error(w, "invalid secret", http.StatusForbidden)
+		return
+	}
+
+	checkin := new(Checkin)
+	if err := json.Unmarshal([]byte(r.PostForm.Get("checkin")), checkin); err != nil {
+		http.Error(w, "invalid checkin", http.StatusBadRequest)
+		return
+	}
+
+	if u := r.PostForm.Get("user"); u != "" {
+		user := new(User)
+		if err := json.Unmarshal([]byte(u), user); err != nil {
+			http.Error(w, "invalid user", http.StatusBadRequest)
+			return
+		}
+
+		if h.OnUserCheckin != nil {
+			h.OnUserCheckin(checkin, user)
+		}
+	} else if h.OnVenueCheckin != nil {
+		h.OnVenueCheckin(checkin)
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
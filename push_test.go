@@ -0,0 +1,122 @@
+package foursquarego
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const pushSecret = "PUSHSECRET"
+
+func pushRequest(form url.Values) *http.Request {
+	r := httptest.NewRequest("POST", "/push", strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return r
+}
+
+func pushForm(t *testing.T, files ...string) url.Values {
+	form := url.Values{"secret": {pushSecret}}
+	for _, name := range files {
+		b, err := getTestFile("./json/push/" + name + ".json")
+		if err != nil {
+			t.Fatalf("Failed to open testfile %s", name)
+		}
+		form.Set(name, string(b))
+	}
+	return form
+}
+
+func TestPushHandler_UserCheckin(t *testing.T) {
+	var checkin *Checkin
+	var user *User
+	h := NewPushHandler(pushSecret)
+	h.OnUserCheckin = func(c *Checkin, u *User) {
+		checkin, user = c, u
+	}
+	h.OnVenueCheckin = func(c *Checkin) {
+		t.Error("OnVenueCheckin called for a user push")
+	}
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, pushRequest(pushForm(t, "checkin", "user")))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	if assert.NotNil(t, checkin) {
+		assert.Equal(t, "5b13f0a21f6e8a002c8512c4", checkin.ID)
+		assert.Equal(t, int64(1528033442), checkin.CreatedAt.Unix())
+		assert.Equal(t, "First one on the patio", checkin.Shout)
+		assert.Equal(t, "5414d0a6498ea3d31a3c64cf", checkin.Venue.ID)
+		assert.Equal(t, "Brewery", checkin.Venue.Categories[0].Name)
+	}
+	if assert.NotNil(t, user) {
+		assert.Equal(t, "349672", user.ID)
+		assert.Equal(t, "self", user.Relationship)
+	}
+}
+
+func TestPushHandler_VenueCheckin(t *testing.T) {
+	var checkin *Checkin
+	h := NewPushHandler(pushSecret)
+	h.OnVenueCheckin = func(c *Checkin) {
+		checkin = c
+	}
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, pushRequest(pushForm(t, "checkin")))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	if assert.NotNil(t, checkin) {
+		assert.Equal(t, "Valerie", checkin.User.FirstName)
+		assert.Equal(t, "Threes Brewing", checkin.Venue.Name)
+	}
+}
+
+func TestPushHandler_Rejects(t *testing.T) {
+	called := false
+	h := NewPushHandler(pushSecret)
+	h.OnUserCheckin = func(*Checkin, *User) { called = true }
+	h.OnVenueCheckin = func(*Checkin) { called = true }
+
+	form := pushForm(t, "checkin", "user")
+	form.Set("secret", "wrong")
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, pushRequest(form))
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	form = pushForm(t, "user")
+	form.Set("checkin", "{not json")
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, pushRequest(form))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("GET", "/push", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+
+	w = httptest.NewRecorder()
+	NewPushHandler("").ServeHTTP(w, pushRequest(url.Values{"secret": {""}}))
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	assert.False(t, called)
+}
+
+func TestPushHandler_Server(t *testing.T) {
+	done := make(chan string, 1)
+	h := NewPushHandler(pushSecret)
+	h.OnUserCheckin = func(c *Checkin, u *User) {
+		done <- c.ID
+	}
+
+	server := httptest.NewServer(h)
+	defer server.Close()
+
+	resp, err := http.PostForm(server.URL, pushForm(t, "checkin", "user"))
+	assert.Nil(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "5b13f0a21f6e8a002c8512c4", <-done)
+}
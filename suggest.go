@@ -0,0 +1,261 @@
+package foursquarego
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ErrBudgetExhausted is sent by a SuggestSession when a query needs a
+// request but the session has made its Budget of requests.
+var ErrBudgetExhausted = errors.New("foursquarego: suggest request budget exhausted")
+
+// suggestDefaultLimit is the number of mini-venues foursquare returns when
+// VenueSuggestParams has no Limit.
+const suggestDefaultLimit = 10
+
+// SuggestResult is the completion of a query typed into a SuggestSession.
+type SuggestResult struct {
+	Query  string
+	Venues []MiniVenue
+	// Cached is true when Venues were filtered from an earlier response
+	// rather than requested.
+	Cached bool
+	Err    error
+}
+
+// suggestFetch requests the mini-venues for params.
+type suggestFetch func(ctx context.Context, params *VenueSuggestParams) ([]MiniVenue, *http.Response, error)
+
+// suggestTimer is the part of time.Timer the debounce uses, so tests can
+// fire it themselves.
+type suggestTimer interface {
+	Stop() bool
+}
+
+func timeAfterFunc(d time.Duration, f func()) suggestTimer {
+	return time.AfterFunc(d, f)
+}
+
+// SuggestSession is a type-ahead over VenueService.SuggestCompletion.
+// Queries are debounced, a new query cancels the request for the one
+// before it and only the result for the latest query is sent. When a
+// response had fewer venues than the limit it holds every match, so
+// queries extending it are answered by filtering it instead of requesting.
+type SuggestSession struct {
+	fetch     suggestFetch
+	afterFunc func(d time.Duration, f func()) suggestTimer
+	params    VenueSuggestParams
+	results   chan SuggestResult
+
+	// Debounce is how long a query waits for the next one before it is
+	// requested.
+	Debounce time.Duration
+	// MinQueryLength in characters. Shorter queries get an empty result
+	// without a request.
+	MinQueryLength int
+	// Budget is the most requests the session makes, no limit if 0.
+	Budget int
+
+	mu       sync.Mutex
+	seq      int
+	timer    suggestTimer
+	cancel   context.CancelFunc
+	cache    map[string]suggestCacheEntry
+	requests int
+	closed   bool
+}
+
+type suggestCacheEntry struct {
+	venues   []MiniVenue
+	complete bool
+}
+
+// NewSuggestSession returns a SuggestSession over
+// VenueService.SuggestCompletion. The Query of params is set for each
+// request.
+func NewSuggestSession(s *VenueService, params VenueSuggestParams) *SuggestSession {
+	return newSuggestSession(s.suggestCompletion, params)
+}
+
+func newSuggestSession(fetch suggestFetch, params VenueSuggestParams) *SuggestSession {
+	return &SuggestSession{
+		fetch:          fetch,
+		afterFunc:      timeAfterFunc,
+		params:         params,
+		results:        make(chan SuggestResult, 1),
+		cache:          make(map[string]suggestCacheEntry),
+		Debounce:       150 * time.Millisecond,
+		MinQueryLength: 3,
+	}
+}
+
+// Results is where the results of queries are sent. A result not yet
+// received is replaced by a newer one. It is closed by Close.
+func (s *SuggestSession) Results() <-chan SuggestResult {
+	return s.results
+}
+
+// Requests returns the number of requests the session has made.
+func (s *SuggestSession) Requests() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests
+}
+
+// Type sets the query, dropping any earlier query that has no result yet.
+func (s *SuggestSession) Type(query string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return
+	}
+
+	s.seq++
+	s.stop()
+
+	query = strings.TrimSpace(query)
+	if utf8.RuneCountInString(query) < s.MinQueryLength {
+		s.send(SuggestResult{Query: query})
+		return
+	}
+	if venues, ok := s.cached(query, true); ok {
+		s.send(SuggestResult{Query: query, Venues: venues, Cached: true})
+		return
+	}
+
+	seq := s.seq
+	s.timer = s.afterFunc(s.Debounce, func() {
+		s.request(seq, query)
+	})
+}
+
+// Close stops the session, cancelling any request, and closes Results.
+func (s *SuggestSession) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return
+	}
+	s.closed = true
+	s.stop()
+	close(s.results)
+}
+
+// request fetches query if it is still the latest one.
+func (s *SuggestSession) request(seq int, query string) {
+	s.mu.Lock()
+	if seq != s.seq || s.closed {
+		s.mu.Unlock()
+		return
+	}
+	if s.Budget > 0 && s.requests >= s.Budget {
+		// Filtering a partial response is better than nothing.
+		venues, _ := s.cached(query, false)
+		s.send(SuggestResult{Query: query, Venues: venues, Cached: venues != nil, Err: ErrBudgetExhausted})
+		s.mu.Unlock()
+		return
+	}
+	s.requests++
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	s.mu.Unlock()
+
+	p := s.params
+	p.Query = query
+	venues, _, err := s.fetch(ctx, &p)
+	cancel()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err == nil {
+		limit := p.Limit
+		if limit <= 0 {
+			limit = suggestDefaultLimit
+		}
+		s.cache[strings.ToLower(query)] = suggestCacheEntry{venues: venues, complete: len(venues) < limit}
+	}
+	if seq != s.seq || s.closed {
+		return
+	}
+	s.cancel = nil
+	s.send(SuggestResult{Query: query, Venues: venues, Err: err})
+}
+
+// cached returns the venues matching query from the response for the
+// longest query it extends. When complete is set that response must
+// have held every match.
+func (s *SuggestSession) cached(query string, complete bool) ([]MiniVenue, bool) {
+	query = strings.ToLower(query)
+	if entry, ok := s.cache[query]; ok {
+		return entry.venues, true
+	}
+
+	best, found := "", false
+	for prefix, entry := range s.cache {
+		if complete && !entry.complete {
+			continue
+		}
+		if strings.HasPrefix(query, prefix) && (!found || len(prefix) > len(best)) {
+			best, found = prefix, true
+		}
+	}
+	if !found {
+		return nil, false
+	}
+
+	venues := []MiniVenue{}
+	for _, v := range s.cache[best].venues {
+		if matchesWordPrefix(v.Name, query) {
+			venues = append(venues, v)
+		}
+	}
+	return venues, true
+}
+
+// stop cancels the pending timer and any request in flight.
+func (s *SuggestSession) stop() {
+	if s.timer != nil {
+		s.timer.Stop()
+		s.timer = nil
+	}
+	if s.cancel != nil {
+		s.cancel()
+		s.cancel = nil
+	}
+}
+
+// send replaces any result not yet received with r. It is called with mu
+// held so it never blocks.
+func (s *SuggestSession) send(r SuggestResult) {
+	select {
+	case <-s.results:
+	default:
+	}
+	s.results <- r
+}
+
+// matchesWordPrefix is true when a word of name starts with the lowercase
+// query, the way foursquare matches partial names.
+func matchesWordPrefix(name, query string) bool {
+	name = strings.ToLower(name)
+	for i := 0; i < len(name); {
+		j := strings.Index(name[i:], query)
+		if j < 0 {
+			return false
+		}
+		j += i
+		prev, _ := utf8.DecodeLastRuneInString(name[:j])
+		if j == 0 || !unicode.IsLetter(prev) && !unicode.IsDigit(prev) {
+			return true
+		}
+		_, size := utf8.DecodeRuneInString(name[j:])
+		i = j + size
+	}
+	return false
+}
@@ -0,0 +1,261 @@
+package foursquarego
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var suggestNames = []string{"Cafe Grumpy", "Blue Bottle Cafe", "Café Integral", "Barcade", "Bar Goto", "The Bar Room"}
+
+// suggestServer answers suggestCompletion with up to two of suggestNames.
+// The query "slow" is held until the request is cancelled.
+type suggestServer struct {
+	mu      sync.Mutex
+	queries []string
+	started chan string
+}
+
+func (s *suggestServer) handler(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		query := r.URL.Query().Get("query")
+		assert.Equal(t, "40.7,-74", r.URL.Query().Get("ll"))
+		s.mu.Lock()
+		s.queries = append(s.queries, query)
+		s.mu.Unlock()
+
+		if query == "slow" {
+			s.started <- query
+			<-r.Context().Done()
+			return
+		}
+
+		var venues []map[string]string
+		for _, name := range suggestNames {
+			if matchesWordPrefix(name, query) && len(venues) < 2 {
+				venues = append(venues, map[string]string{"id": name, "name": name})
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"meta":     map[string]int{"code": 200},
+			"response": map[string]interface{}{"minivenues": venues},
+		})
+	}
+}
+
+func (s *suggestServer) requested() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.queries...)
+}
+
+// fakeTimers stands in for time.AfterFunc so the debounce only fires when
+// a test says so.
+type fakeTimers struct {
+	mu     sync.Mutex
+	timers []*fakeTimer
+}
+
+type fakeTimer struct {
+	timers *fakeTimers
+	f      func()
+	done   bool
+}
+
+func (c *fakeTimers) afterFunc(d time.Duration, f func()) suggestTimer {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	t := &fakeTimer{timers: c, f: f}
+	c.timers = append(c.timers, t)
+	return t
+}
+
+func (t *fakeTimer) Stop() bool {
+	t.timers.mu.Lock()
+	defer t.timers.mu.Unlock()
+	stopped := !t.done
+	t.done = true
+	return stopped
+}
+
+// fire runs the timers that haven't been stopped or fired and returns how
+// many there were.
+func (c *fakeTimers) fire() int {
+	c.mu.Lock()
+	var due []func()
+	for _, t := range c.timers {
+		if !t.done {
+			t.done = true
+			due = append(due, t.f)
+		}
+	}
+	c.mu.Unlock()
+
+	for _, f := range due {
+		f()
+	}
+	return len(due)
+}
+
+func newTestSuggestSession(t *testing.T, params VenueSuggestParams) (*SuggestSession, *suggestServer, *fakeTimers, func()) {
+	httpClient, mux, server := testServer()
+	suggest := &suggestServer{started: make(chan string, 1)}
+	mux.HandleFunc("/v2/venues/suggestCompletion", suggest.handler(t))
+
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	s := NewSuggestSession(client.Venues, params)
+	timers := new(fakeTimers)
+	s.afterFunc = timers.afterFunc
+
+	return s, suggest, timers, func() {
+		s.Close()
+		server.Close()
+	}
+}
+
+// noResult fails if a result is waiting.
+func noResult(t *testing.T, s *SuggestSession) {
+	select {
+	case r := <-s.Results():
+		t.Errorf("unexpected result for %q", r.Query)
+	default:
+	}
+}
+
+func venueNames(venues []MiniVenue) []string {
+	var names []string
+	for _, v := range venues {
+		names = append(names, v.Name)
+	}
+	return names
+}
+
+func TestSuggestSession(t *testing.T) {
+	s, server, timers, done := newTestSuggestSession(t, VenueSuggestParams{LatLong: "40.7,-74", Limit: 2})
+	defer done()
+
+	s.Type("ca")
+	r := <-s.Results()
+	assert.Equal(t, "ca", r.Query)
+	assert.Nil(t, r.Venues)
+	assert.Equal(t, 0, timers.fire())
+
+	s.Type("caf")
+	s.Type("cafe")
+	noResult(t, s)
+	assert.Equal(t, 1, timers.fire())
+	r = <-s.Results()
+	assert.Equal(t, "cafe", r.Query)
+	assert.Nil(t, r.Err)
+	assert.Equal(t, false, r.Cached)
+	assert.Equal(t, []string{"Cafe Grumpy", "Blue Bottle Cafe"}, venueNames(r.Venues))
+	assert.Equal(t, []string{"cafe"}, server.requested())
+
+	// Two venues is the limit so there may be more for "cafe g".
+	s.Type("cafe g")
+	assert.Equal(t, 1, timers.fire())
+	r = <-s.Results()
+	assert.Equal(t, "cafe g", r.Query)
+	assert.Equal(t, false, r.Cached)
+	assert.Equal(t, []string{"Cafe Grumpy"}, venueNames(r.Venues))
+
+	s.Type("Cafe Gr")
+	r = <-s.Results()
+	assert.Equal(t, "Cafe Gr", r.Query)
+	assert.Equal(t, true, r.Cached)
+	assert.Equal(t, []string{"Cafe Grumpy"}, venueNames(r.Venues))
+
+	s.Type("cafe gx")
+	r = <-s.Results()
+	assert.Equal(t, true, r.Cached)
+	assert.Empty(t, r.Venues)
+
+	assert.Equal(t, 0, timers.fire())
+	assert.Equal(t, 2, s.Requests())
+	assert.Equal(t, []string{"cafe", "cafe g"}, server.requested())
+}
+
+func TestSuggestSession_Stale(t *testing.T) {
+	s, server, timers, done := newTestSuggestSession(t, VenueSuggestParams{LatLong: "40.7,-74"})
+	defer done()
+
+	s.Type("slow")
+	slow := make(chan struct{})
+	go func() {
+		timers.fire()
+		close(slow)
+	}()
+	assert.Equal(t, "slow", <-server.started)
+
+	// The new query cancels the request for "slow", which the server holds
+	// until it is.
+	s.Type("bar")
+	<-slow
+	noResult(t, s)
+
+	assert.Equal(t, 1, timers.fire())
+	r := <-s.Results()
+	assert.Equal(t, "bar", r.Query)
+	assert.Nil(t, r.Err)
+	assert.Equal(t, []string{"Barcade", "Bar Goto"}, venueNames(r.Venues))
+	noResult(t, s)
+	assert.Equal(t, []string{"slow", "bar"}, server.requested())
+}
+
+func TestSuggestSession_Budget(t *testing.T) {
+	s, server, timers, done := newTestSuggestSession(t, VenueSuggestParams{LatLong: "40.7,-74", Limit: 2})
+	s.Budget = 1
+	defer done()
+
+	s.Type("bar")
+	timers.fire()
+	r := <-s.Results()
+	assert.Nil(t, r.Err)
+
+	s.Type("bar g")
+	timers.fire()
+	r = <-s.Results()
+	assert.Equal(t, "bar g", r.Query)
+	assert.Equal(t, ErrBudgetExhausted, r.Err)
+	assert.Equal(t, true, r.Cached)
+	assert.Equal(t, []string{"Bar Goto"}, venueNames(r.Venues))
+
+	s.Type("blue")
+	timers.fire()
+	r = <-s.Results()
+	assert.Equal(t, ErrBudgetExhausted, r.Err)
+	assert.Nil(t, r.Venues)
+	assert.Equal(t, []string{"bar"}, server.requested())
+}
+
+func TestSuggestSession_Debounce(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	suggest := new(suggestServer)
+	mux.HandleFunc("/v2/venues/suggestCompletion", suggest.handler(t))
+
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	s := NewSuggestSession(client.Venues, VenueSuggestParams{LatLong: "40.7,-74"})
+	s.Debounce = time.Millisecond
+	defer s.Close()
+
+	s.Type("bar")
+	r := <-s.Results()
+	assert.Equal(t, "bar", r.Query)
+	assert.Equal(t, []string{"Barcade", "Bar Goto"}, venueNames(r.Venues))
+}
+
+func TestMatchesWordPrefix(t *testing.T) {
+	assert.True(t, matchesWordPrefix("Blue Bottle Cafe", "cafe"))
+	assert.True(t, matchesWordPrefix("Blue Bottle Cafe", "blue bot"))
+	assert.True(t, matchesWordPrefix("Joe's (Coffee)", "coff"))
+	assert.False(t, matchesWordPrefix("Barcade", "cade"))
+	assert.False(t, matchesWordPrefix("Barcade", "bard"))
+}
@@ -0,0 +1,181 @@
+package foursquarego
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/dghubble/sling"
+)
+
+// TipService provides a method for accessing Foursquare tip endpoints
+type TipService struct {
+	sling *sling.Sling
+}
+
+func newTipService(sling *sling.Sling) *TipService {
+	return &TipService{
+		sling: sling.Path("tips/"),
+	}
+}
+
+type tipDetailsResp struct {
+	Tip Tip `json:"tip"`
+}
+
+// Details gets all the data for a tip
+// https://developer.foursquare.com/docs/api/tips/details
+func (s *TipService) Details(id string) (*Tip, *http.Response, error) {
+	tip := new(tipDetailsResp)
+	response := new(Response)
+
+	resp, err := s.sling.New().Get(id).Receive(response, response)
+	if err == nil {
+		json.Unmarshal(response.Response, tip)
+	}
+
+	return &tip.Tip, resp, relevantError(err, *response)
+}
+
+// TipAddParams are the parameters for TipService.Add
+type TipAddParams struct {
+	VenueID   string `url:"venueId"`
+	Text      string `url:"text"`
+	URL       string `url:"url,omitempty"`
+	PhotoID   string `url:"photoId,omitempty"`
+	Broadcast string `url:"broadcast,omitempty"`
+}
+
+// Add creates a tip on a venue for the acting user.
+// https://developer.foursquare.com/docs/api/tips/add
+func (s *TipService) Add(params *TipAddParams) (*Tip, *http.Response, error) {
+	tip := new(tipDetailsResp)
+	response := new(Response)
+
+	resp, err := s.sling.New().Post("add").BodyForm(params).Receive(response, response)
+	if err == nil {
+		json.Unmarshal(response.Response, tip)
+	}
+
+	return &tip.Tip, resp, relevantError(err, *response)
+}
+
+type tipLikesResp struct {
+	Likes Likes `json:"likes"`
+}
+
+type tipLikeParams struct {
+	Set int `url:"set"`
+}
+
+// Like makes the acting user like a tip and returns its updated likes.
+// https://developer.foursquare.com/docs/api/tips/like
+func (s *TipService) Like(id string) (*Likes, *http.Response, error) {
+	return s.like(id, 1)
+}
+
+// Unlike removes the acting user's like from a tip and returns its
+// updated likes.
+// https://developer.foursquare.com/docs/api/tips/like
+func (s *TipService) Unlike(id string) (*Likes, *http.Response, error) {
+	return s.like(id, 0)
+}
+
+func (s *TipService) like(id string, set int) (*Likes, *http.Response, error) {
+	likes := new(tipLikesResp)
+	response := new(Response)
+
+	resp, err := s.sling.New().Post(id+"/like").BodyForm(&tipLikeParams{Set: set}).Receive(response, response)
+	if err == nil {
+		json.Unmarshal(response.Response, likes)
+	}
+
+	return &likes.Likes, resp, relevantError(err, *response)
+}
+
+// TipProblem are the problem options on TipService.Flag
+type TipProblem string
+
+// Options for TipProblem
+const (
+	ProblemOffensive        TipProblem = "offensive"
+	ProblemSpam             TipProblem = "spam"
+	ProblemNoLongerRelevant TipProblem = "nolongerrelevant"
+)
+
+// TipFlagParams are the parameters for TipService.Flag
+type TipFlagParams struct {
+	TipID   string     `url:"-"`
+	Problem TipProblem `url:"problem"`
+	Comment string     `url:"comment,omitempty"`
+}
+
+// Flag reports a tip to foursquare's moderators.
+// https://developer.foursquare.com/docs/api/tips/flag
+func (s *TipService) Flag(params *TipFlagParams) (*http.Response, error) {
+	response := new(Response)
+
+	resp, err := s.sling.New().Post(params.TipID+"/flag").BodyForm(params).Receive(response, response)
+	return resp, relevantError(err, *response)
+}
+
+// TipListedParams are the parameters for TipService.Listed
+type TipListedParams struct {
+	TipID string      `url:"-"`
+	Group ListedGroup `url:"group,omitempty"`
+}
+
+type tipListedResp struct {
+	Lists Listed `json:"lists"`
+}
+
+// Listed returns the lists that this tip appears on
+// https://developer.foursquare.com/docs/api/tips/listed
+func (s *TipService) Listed(params *TipListedParams) (*Listed, *http.Response, error) {
+	lists := new(tipListedResp)
+	response := new(Response)
+
+	resp, err := s.sling.New().Get(params.TipID+"/listed").QueryStruct(params).Receive(response, response)
+	if err == nil {
+		json.Unmarshal(response.Response, lists)
+	}
+
+	return &lists.Lists, resp, relevantError(err, *response)
+}
+
+// TipSaves is the response for TipService.Saves
+type TipSaves struct {
+	Count int    `json:"count"`
+	Items []User `json:"items"`
+}
+
+type tipSavesResp struct {
+	Saves TipSaves `json:"saves"`
+}
+
+// Saves returns the users who saved this tip
+// https://developer.foursquare.com/docs/api/tips/saves
+func (s *TipService) Saves(id string) (*TipSaves, *http.Response, error) {
+	saves := new(tipSavesResp)
+	response := new(Response)
+
+	resp, err := s.sling.New().Get(id+"/saves").Receive(response, response)
+	if err == nil {
+		json.Unmarshal(response.Response, saves)
+	}
+
+	return &saves.Saves, resp, relevantError(err, *response)
+}
+
+// Unmark removes the tip from the acting user's saved tips.
+// https://developer.foursquare.com/docs/api/tips/unmark
+func (s *TipService) Unmark(id string) (*Tip, *http.Response, error) {
+	tip := new(tipDetailsResp)
+	response := new(Response)
+
+	resp, err := s.sling.New().Post(id+"/unmark").Receive(response, response)
+	if err == nil {
+		json.Unmarshal(response.Response, tip)
+	}
+
+	return &tip.Tip, resp, relevantError(err, *response)
+}
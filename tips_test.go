@@ -0,0 +1,222 @@
+package foursquarego
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTipService_Details(t *testing.T) {
+	const filePath = "./json/tips/details.json"
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/v2/tips/5aff27a1603d2a002c81fac1", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQueryNoUser(t, map[string]string{}, r)
+
+		b, err := getTestFile(filePath)
+		if err != nil {
+			t.Fatalf("Failed to open testfile %s", filePath)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+	})
+
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	tip, _, err := client.Tips.Details("5aff27a1603d2a002c81fac1")
+	assert.Nil(t, err)
+
+	assert.Equal(t, "5aff27a1603d2a002c81fac1", tip.ID)
+	assert.Equal(t, int64(1526671265), tip.CreatedAt.Unix())
+	assert.Equal(t, "The patio out back is the best place in Gowanus on a summer afternoon.", tip.Text)
+	assert.Equal(t, 4, tip.Likes.Count)
+	assert.Equal(t, "Valerie", tip.Likes.Groups[0].Items[0].FirstName)
+	assert.Equal(t, 2, tip.Todo.Count)
+	assert.Equal(t, "349672", tip.User.ID)
+	assert.Equal(t, "5414d0a6498ea3d31a3c64cf", tip.Venue.ID)
+	assert.Equal(t, "Threes Brewing", tip.Venue.Name)
+}
+
+func TestTipService_Add(t *testing.T) {
+	const filePath = "./json/tips/details.json"
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/v2/tips/add", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		assertQueryNoUser(t, map[string]string{}, r)
+		assertForm(t, map[string]string{
+			"venueId": "5414d0a6498ea3d31a3c64cf",
+			"text":    "The patio out back is the best place in Gowanus on a summer afternoon.",
+			"url":     "http://www.threesbrewing.com",
+		}, r)
+
+		b, err := getTestFile(filePath)
+		if err != nil {
+			t.Fatalf("Failed to open testfile %s", filePath)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+	})
+
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	tip, _, err := client.Tips.Add(&TipAddParams{
+		VenueID: "5414d0a6498ea3d31a3c64cf",
+		Text:    "The patio out back is the best place in Gowanus on a summer afternoon.",
+		URL:     "http://www.threesbrewing.com",
+	})
+	assert.Nil(t, err)
+
+	assert.Equal(t, "5aff27a1603d2a002c81fac1", tip.ID)
+}
+
+func TestTipService_LikeUnlike(t *testing.T) {
+	const filePath = "./json/tips/like.json"
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	var sets []string
+	mux.HandleFunc("/v2/tips/5aff27a1603d2a002c81fac1/like", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		assertQueryNoUser(t, map[string]string{}, r)
+		r.ParseForm()
+		sets = append(sets, r.PostForm.Get("set"))
+
+		b, err := getTestFile(filePath)
+		if err != nil {
+			t.Fatalf("Failed to open testfile %s", filePath)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+	})
+
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	likes, _, err := client.Tips.Like("5aff27a1603d2a002c81fac1")
+	assert.Nil(t, err)
+	assert.Equal(t, 5, likes.Count)
+	assert.Equal(t, "You, Valerie K. and 3 others", likes.Summary)
+
+	_, _, err = client.Tips.Unlike("5aff27a1603d2a002c81fac1")
+	assert.Nil(t, err)
+
+	assert.Equal(t, []string{"1", "0"}, sets)
+}
+
+func TestTipService_Flag(t *testing.T) {
+	const filePath = "./json/tips/flag.json"
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/v2/tips/5aff27a1603d2a002c81fac1/flag", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		assertQueryNoUser(t, map[string]string{}, r)
+		assertForm(t, map[string]string{
+			"problem": "spam",
+			"comment": "Advertising",
+		}, r)
+
+		b, err := getTestFile(filePath)
+		if err != nil {
+			t.Fatalf("Failed to open testfile %s", filePath)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+	})
+
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	_, err := client.Tips.Flag(&TipFlagParams{
+		TipID:   "5aff27a1603d2a002c81fac1",
+		Problem: ProblemSpam,
+		Comment: "Advertising",
+	})
+	assert.Nil(t, err)
+}
+
+func TestTipService_Listed(t *testing.T) {
+	const filePath = "./json/tips/listed.json"
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/v2/tips/5aff27a1603d2a002c81fac1/listed", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQueryNoUser(t, map[string]string{
+			"group": "other",
+		}, r)
+
+		b, err := getTestFile(filePath)
+		if err != nil {
+			t.Fatalf("Failed to open testfile %s", filePath)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+	})
+
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	lists, _, err := client.Tips.Listed(&TipListedParams{
+		TipID: "5aff27a1603d2a002c81fac1",
+		Group: GroupListedOther,
+	})
+	assert.Nil(t, err)
+
+	assert.Equal(t, 1, lists.Count)
+	assert.Equal(t, "57757f23498e8e90405a5cd9", lists.Groups[0].Items[0].ID)
+	assert.Equal(t, 12, lists.Groups[0].Items[0].Followers.Count)
+}
+
+func TestTipService_Saves(t *testing.T) {
+	const filePath = "./json/tips/saves.json"
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/v2/tips/5aff27a1603d2a002c81fac1/saves", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQueryNoUser(t, map[string]string{}, r)
+
+		b, err := getTestFile(filePath)
+		if err != nil {
+			t.Fatalf("Failed to open testfile %s", filePath)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+	})
+
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	saves, _, err := client.Tips.Saves("5aff27a1603d2a002c81fac1")
+	assert.Nil(t, err)
+
+	assert.Equal(t, 2, saves.Count)
+	assert.Equal(t, "68150", saves.Items[0].ID)
+}
+
+func TestTipService_Unmark(t *testing.T) {
+	const filePath = "./json/tips/details.json"
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/v2/tips/5aff27a1603d2a002c81fac1/unmark", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		assertQueryNoUser(t, map[string]string{}, r)
+
+		b, err := getTestFile(filePath)
+		if err != nil {
+			t.Fatalf("Failed to open testfile %s", filePath)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+	})
+
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	tip, _, err := client.Tips.Unmark("5aff27a1603d2a002c81fac1")
+	assert.Nil(t, err)
+
+	assert.Equal(t, "5aff27a1603d2a002c81fac1", tip.ID)
+}
@@ -0,0 +1,101 @@
+package foursquarego
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/dghubble/sling"
+)
+
+// UpdateService provides a method for accessing Foursquare update endpoints
+type UpdateService struct {
+	sling *sling.Sling
+}
+
+func newUpdateService(sling *sling.Sling) *UpdateService {
+	return &UpdateService{
+		sling: sling.Path("updates/"),
+	}
+}
+
+// Update is a notification for the acting user, a friend liking their tip
+// for example.
+type Update struct {
+	IDs       []string     `json:"ids"`
+	CreatedAt Timestamp    `json:"createdAt"`
+	Unread    bool         `json:"unread"`
+	ImageType string       `json:"imageType"`
+	Image     Icon         `json:"image"`
+	Icon      Icon         `json:"icon"`
+	Target    UpdateTarget `json:"target"`
+	Text      string       `json:"text"`
+	Entities  []Entitie    `json:"entities"`
+}
+
+// UpdateTarget is what an Update is about. Object depends on the Type,
+// a user, venue or tip for example.
+type UpdateTarget struct {
+	Type   string  `json:"type"`
+	Object Omitted `json:"object"`
+}
+
+// UpdateNotifications is the response for UpdateService.Notifications
+type UpdateNotifications struct {
+	Count int      `json:"count"`
+	Items []Update `json:"items"`
+}
+
+type updateNotificationsParams struct {
+	Limit int `url:"limit,omitempty"`
+}
+
+type updateNotificationsResp struct {
+	Notifications UpdateNotifications `json:"notifications"`
+}
+
+// Notifications returns the acting user's notifications, newest first.
+// https://developer.foursquare.com/docs/api/updates/notifications
+func (s *UpdateService) Notifications(limit int) (*UpdateNotifications, *http.Response, error) {
+	notifications := new(updateNotificationsResp)
+	response := new(Response)
+
+	resp, err := s.sling.New().Get("notifications").QueryStruct(&updateNotificationsParams{Limit: limit}).Receive(response, response)
+	if err == nil {
+		json.Unmarshal(response.Response, notifications)
+	}
+
+	return &notifications.Notifications, resp, relevantError(err, *response)
+}
+
+type updateResp struct {
+	Update Update `json:"update"`
+}
+
+// Details gets all the data for an update
+// https://developer.foursquare.com/docs/api/updates/details
+func (s *UpdateService) Details(id string) (*Update, *http.Response, error) {
+	update := new(updateResp)
+	response := new(Response)
+
+	resp, err := s.sling.New().Get(id).Receive(response, response)
+	if err == nil {
+		json.Unmarshal(response.Response, update)
+	}
+
+	return &update.Update, resp, relevantError(err, *response)
+}
+
+type updateMarkReadParams struct {
+	HighWatermark time.Time `url:"highWatermark,unix"`
+}
+
+// MarkNotificationsRead marks the notifications created up to and including
+// highWatermark as read. Use the CreatedAt of the newest Update shown.
+// https://developer.foursquare.com/docs/api/updates/marknotificationsread
+func (s *UpdateService) MarkNotificationsRead(highWatermark time.Time) (*http.Response, error) {
+	response := new(Response)
+
+	resp, err := s.sling.New().Post("marknotificationsread").BodyForm(&updateMarkReadParams{HighWatermark: highWatermark}).Receive(response, response)
+	return resp, relevantError(err, *response)
+}
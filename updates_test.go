@@ -0,0 +1,99 @@
+package foursquarego
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateService_Notifications(t *testing.T) {
+	const filePath = "./json/updates/notifications.json"
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/v2/updates/notifications", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQueryNoUser(t, map[string]string{
+			"limit": "20",
+		}, r)
+
+		b, err := getTestFile(filePath)
+		if err != nil {
+			t.Fatalf("Failed to open testfile %s", filePath)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+	})
+
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	notifications, _, err := client.Updates.Notifications(20)
+	assert.Nil(t, err)
+
+	assert.Equal(t, 2, notifications.Count)
+	assert.Len(t, notifications.Items, 2)
+	assert.Equal(t, true, notifications.Items[0].Unread)
+	assert.Equal(t, "list", notifications.Items[1].Target.Type)
+	assert.Equal(t, "Matt C. followed your list Brooklyn Breweries", notifications.Items[1].Text)
+}
+
+func TestUpdateService_Details(t *testing.T) {
+	const filePath = "./json/updates/details.json"
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/v2/updates/5b12e5f01f6e8a002c83a1b2", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQueryNoUser(t, map[string]string{}, r)
+
+		b, err := getTestFile(filePath)
+		if err != nil {
+			t.Fatalf("Failed to open testfile %s", filePath)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+	})
+
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	update, _, err := client.Updates.Details("5b12e5f01f6e8a002c83a1b2")
+	assert.Nil(t, err)
+
+	assert.Equal(t, []string{"5b12e5f01f6e8a002c83a1b2"}, update.IDs)
+	assert.Equal(t, int64(1527965168), update.CreatedAt.Unix())
+	assert.Equal(t, "user", update.ImageType)
+	assert.Equal(t, "/349672-XYZ.jpg", update.Image.Suffix)
+	assert.Equal(t, "https://ss3.4sqi.net/img/notifications/like_", update.Icon.Prefix)
+	assert.Equal(t, "tip", update.Target.Type)
+	assert.Equal(t, "Valerie K. liked your tip at Threes Brewing", update.Text)
+	assert.Equal(t, []int{33, 47}, update.Entities[1].Indices)
+	assert.Equal(t, "venue", update.Entities[1].Type)
+}
+
+func TestUpdateService_MarkNotificationsRead(t *testing.T) {
+	const filePath = "./json/updates/marknotificationsread.json"
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/v2/updates/marknotificationsread", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		assertQueryNoUser(t, map[string]string{}, r)
+		assertForm(t, map[string]string{
+			"highWatermark": "1527965168",
+		}, r)
+
+		b, err := getTestFile(filePath)
+		if err != nil {
+			t.Fatalf("Failed to open testfile %s", filePath)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+	})
+
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	_, err := client.Updates.MarkNotificationsRead(time.Unix(1527965168, 0))
+	assert.Nil(t, err)
+}
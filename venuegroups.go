@@ -0,0 +1,182 @@
+package foursquarego
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/dghubble/sling"
+)
+
+// VenueGroupService provides a method for accessing Foursquare venue group
+// endpoints. Venue groups are used by managers of many venues.
+type VenueGroupService struct {
+	sling *sling.Sling
+}
+
+func newVenueGroupService(sling *sling.Sling) *VenueGroupService {
+	return &VenueGroupService{
+		sling: sling.Path("venuegroups/"),
+	}
+}
+
+// VenueGroup is a named set of venues managed by the acting user.
+type VenueGroup struct {
+	ID     string           `json:"id"`
+	Name   string           `json:"name"`
+	Venues VenueGroupVenues `json:"venues"`
+}
+
+// VenueGroupVenues are the venues in a VenueGroup.
+type VenueGroupVenues struct {
+	Count int     `json:"count"`
+	Items []Venue `json:"items"`
+}
+
+type venueGroupResp struct {
+	VenueGroup VenueGroup `json:"venueGroup"`
+}
+
+// VenueGroupAddParams are the parameters for VenueGroupService.Add
+type VenueGroupAddParams struct {
+	Name     string   `url:"name"`
+	VenueIDs []string `url:"venueId,comma,omitempty"`
+}
+
+// Add creates a venue group.
+// https://developer.foursquare.com/docs/api/venuegroups/add
+func (s *VenueGroupService) Add(params *VenueGroupAddParams) (*VenueGroup, *http.Response, error) {
+	group := new(venueGroupResp)
+	response := new(Response)
+
+	resp, err := s.sling.New().Post("add").BodyForm(params).Receive(response, response)
+	if err == nil {
+		json.Unmarshal(response.Response, group)
+	}
+
+	return &group.VenueGroup, resp, relevantError(err, *response)
+}
+
+// Delete removes a venue group.
+// https://developer.foursquare.com/docs/api/venuegroups/delete
+func (s *VenueGroupService) Delete(id string) (*http.Response, error) {
+	response := new(Response)
+
+	resp, err := s.sling.New().Post(id+"/delete").Receive(response, response)
+	return resp, relevantError(err, *response)
+}
+
+// Details gets a venue group and its venues.
+// https://developer.foursquare.com/docs/api/venuegroups/details
+func (s *VenueGroupService) Details(id string) (*VenueGroup, *http.Response, error) {
+	group := new(venueGroupResp)
+	response := new(Response)
+
+	resp, err := s.sling.New().Get(id).Receive(response, response)
+	if err == nil {
+		json.Unmarshal(response.Response, group)
+	}
+
+	return &group.VenueGroup, resp, relevantError(err, *response)
+}
+
+// VenueGroupUpdateParams are the parameters for VenueGroupService.Update.
+// When VenueIDs is set it replaces the venues in the group.
+type VenueGroupUpdateParams struct {
+	GroupID  string   `url:"-"`
+	Name     string   `url:"name,omitempty"`
+	VenueIDs []string `url:"venueId,comma,omitempty"`
+}
+
+// Update changes the name or venues of a venue group.
+// https://developer.foursquare.com/docs/api/venuegroups/update
+func (s *VenueGroupService) Update(params *VenueGroupUpdateParams) (*VenueGroup, *http.Response, error) {
+	group := new(venueGroupResp)
+	response := new(Response)
+
+	resp, err := s.sling.New().Post(params.GroupID+"/update").BodyForm(params).Receive(response, response)
+	if err == nil {
+		json.Unmarshal(response.Response, group)
+	}
+
+	return &group.VenueGroup, resp, relevantError(err, *response)
+}
+
+// VenueGroupVenuesParams are the parameters for VenueGroupService.AddVenue
+// and VenueGroupService.RemoveVenue
+type VenueGroupVenuesParams struct {
+	GroupID  string   `url:"-"`
+	VenueIDs []string `url:"venueId,comma"`
+}
+
+// AddVenue adds venues to a venue group.
+// https://developer.foursquare.com/docs/api/venuegroups/addvenue
+func (s *VenueGroupService) AddVenue(params *VenueGroupVenuesParams) (*http.Response, error) {
+	response := new(Response)
+
+	resp, err := s.sling.New().Post(params.GroupID+"/addvenue").BodyForm(params).Receive(response, response)
+	return resp, relevantError(err, *response)
+}
+
+// RemoveVenue removes venues from a venue group.
+// https://developer.foursquare.com/docs/api/venuegroups/removevenue
+func (s *VenueGroupService) RemoveVenue(params *VenueGroupVenuesParams) (*http.Response, error) {
+	response := new(Response)
+
+	resp, err := s.sling.New().Post(params.GroupID+"/removevenue").BodyForm(params).Receive(response, response)
+	return resp, relevantError(err, *response)
+}
+
+// Campaign is a special that runs at the venues in a venue group.
+type Campaign struct {
+	ID       string    `json:"id"`
+	Special  Omitted   `json:"special"`
+	StartsAt Timestamp `json:"startsAt"`
+	EndsAt   Timestamp `json:"endsAt"`
+	Status   string    `json:"status"`
+}
+
+type venueGroupCampaignsResp struct {
+	Campaigns struct {
+		Count int        `json:"count"`
+		Items []Campaign `json:"items"`
+	} `json:"campaigns"`
+}
+
+// Campaigns returns the campaigns for a venue group.
+// https://developer.foursquare.com/docs/api/venuegroups/campaigns
+func (s *VenueGroupService) Campaigns(id string) ([]Campaign, *http.Response, error) {
+	campaigns := new(venueGroupCampaignsResp)
+	response := new(Response)
+
+	resp, err := s.sling.New().Get(id+"/campaigns").Receive(response, response)
+	if err == nil {
+		json.Unmarshal(response.Response, campaigns)
+	}
+
+	return campaigns.Campaigns.Items, resp, relevantError(err, *response)
+}
+
+// VenueGroupTimeseriesParams are the parameters for
+// VenueGroupService.Timeseries. If EndAt is not set foursquare uses the
+// current time.
+type VenueGroupTimeseriesParams struct {
+	GroupID string            `url:"-"`
+	StartAt time.Time         `url:"startAt,unix"`
+	EndAt   time.Time         `url:"endAt,unix,omitempty"`
+	Fields  []TimeSeriesField `url:"fields,comma,omitempty"`
+}
+
+// Timeseries returns daily stats for each venue in the venue group.
+// https://developer.foursquare.com/docs/api/venuegroups/timeseries
+func (s *VenueGroupService) Timeseries(params *VenueGroupTimeseriesParams) ([]TimeSeries, *http.Response, error) {
+	series := new(timeseriesResp)
+	response := new(Response)
+
+	resp, err := s.sling.New().Get(params.GroupID+"/timeseries").QueryStruct(params).Receive(response, response)
+	if err == nil {
+		json.Unmarshal(response.Response, series)
+	}
+
+	return series.TimeSeries, resp, relevantError(err, *response)
+}
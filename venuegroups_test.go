@@ -0,0 +1,221 @@
+package foursquarego
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVenueGroupService_Add(t *testing.T) {
+	const filePath = "./json/venuegroups/details.json"
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/v2/venuegroups/add", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		assertQueryNoUser(t, map[string]string{}, r)
+		assertForm(t, map[string]string{
+			"name":    "Manhattan",
+			"venueId": "49e4e3b2f964a52062641fe3,4a5a4f0ef964a52048b91fe3",
+		}, r)
+
+		b, err := getTestFile(filePath)
+		if err != nil {
+			t.Fatalf("Failed to open testfile %s", filePath)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+	})
+
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	group, _, err := client.VenueGroups.Add(&VenueGroupAddParams{
+		Name:     "Manhattan",
+		VenueIDs: []string{"49e4e3b2f964a52062641fe3", "4a5a4f0ef964a52048b91fe3"},
+	})
+	assert.Nil(t, err)
+
+	assert.Equal(t, "5b0f2a3c1f6e8a002c7e10a2", group.ID)
+	assert.Equal(t, "Manhattan", group.Name)
+	assert.Equal(t, 2, group.Venues.Count)
+}
+
+func TestVenueGroupService_Delete(t *testing.T) {
+	const filePath = "./json/venuegroups/empty.json"
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/v2/venuegroups/5b0f2a3c1f6e8a002c7e10a2/delete", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		assertQueryNoUser(t, map[string]string{}, r)
+
+		b, err := getTestFile(filePath)
+		if err != nil {
+			t.Fatalf("Failed to open testfile %s", filePath)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+	})
+
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	_, err := client.VenueGroups.Delete("5b0f2a3c1f6e8a002c7e10a2")
+	assert.Nil(t, err)
+}
+
+func TestVenueGroupService_Details(t *testing.T) {
+	const filePath = "./json/venuegroups/details.json"
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/v2/venuegroups/5b0f2a3c1f6e8a002c7e10a2", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQueryNoUser(t, map[string]string{}, r)
+
+		b, err := getTestFile(filePath)
+		if err != nil {
+			t.Fatalf("Failed to open testfile %s", filePath)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+	})
+
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	group, _, err := client.VenueGroups.Details("5b0f2a3c1f6e8a002c7e10a2")
+	assert.Nil(t, err)
+
+	assert.Equal(t, "Manhattan", group.Name)
+	assert.Len(t, group.Venues.Items, 2)
+	assert.Equal(t, "4a5a4f0ef964a52048b91fe3", group.Venues.Items[1].ID)
+	assert.Equal(t, "405 W 23rd St", group.Venues.Items[1].Location.Address)
+	assert.Equal(t, 5210, group.Venues.Items[1].Stats.CheckinsCount)
+}
+
+func TestVenueGroupService_Update(t *testing.T) {
+	const filePath = "./json/venuegroups/details.json"
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/v2/venuegroups/5b0f2a3c1f6e8a002c7e10a2/update", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		assertQueryNoUser(t, map[string]string{}, r)
+		assertForm(t, map[string]string{
+			"name": "Manhattan",
+		}, r)
+
+		b, err := getTestFile(filePath)
+		if err != nil {
+			t.Fatalf("Failed to open testfile %s", filePath)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+	})
+
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	group, _, err := client.VenueGroups.Update(&VenueGroupUpdateParams{
+		GroupID: "5b0f2a3c1f6e8a002c7e10a2",
+		Name:    "Manhattan",
+	})
+	assert.Nil(t, err)
+
+	assert.Equal(t, "5b0f2a3c1f6e8a002c7e10a2", group.ID)
+}
+
+func TestVenueGroupService_AddRemoveVenue(t *testing.T) {
+	const filePath = "./json/venuegroups/empty.json"
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		assertQueryNoUser(t, map[string]string{}, r)
+		assertForm(t, map[string]string{
+			"venueId": "4a5a4f0ef964a52048b91fe3",
+		}, r)
+
+		b, err := getTestFile(filePath)
+		if err != nil {
+			t.Fatalf("Failed to open testfile %s", filePath)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+	}
+	mux.HandleFunc("/v2/venuegroups/5b0f2a3c1f6e8a002c7e10a2/addvenue", handler)
+	mux.HandleFunc("/v2/venuegroups/5b0f2a3c1f6e8a002c7e10a2/removevenue", handler)
+
+	params := &VenueGroupVenuesParams{
+		GroupID:  "5b0f2a3c1f6e8a002c7e10a2",
+		VenueIDs: []string{"4a5a4f0ef964a52048b91fe3"},
+	}
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	_, err := client.VenueGroups.AddVenue(params)
+	assert.Nil(t, err)
+	_, err = client.VenueGroups.RemoveVenue(params)
+	assert.Nil(t, err)
+}
+
+func TestVenueGroupService_Campaigns(t *testing.T) {
+	const filePath = "./json/venuegroups/campaigns.json"
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/v2/venuegroups/5b0f2a3c1f6e8a002c7e10a2/campaigns", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQueryNoUser(t, map[string]string{}, r)
+
+		b, err := getTestFile(filePath)
+		if err != nil {
+			t.Fatalf("Failed to open testfile %s", filePath)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+	})
+
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	campaigns, _, err := client.VenueGroups.Campaigns("5b0f2a3c1f6e8a002c7e10a2")
+	assert.Nil(t, err)
+
+	assert.Len(t, campaigns, 1)
+	assert.Equal(t, "5b0f2b411f6e8a002c7e10c9", campaigns[0].ID)
+	assert.Equal(t, "active", campaigns[0].Status)
+	assert.Equal(t, int64(1527811200), campaigns[0].StartsAt.Unix())
+	assert.Equal(t, int64(1530403200), campaigns[0].EndsAt.Unix())
+}
+
+func TestVenueGroupService_Timeseries(t *testing.T) {
+	const filePath = "./json/venuegroups/timeseries.json"
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/v2/venuegroups/5b0f2a3c1f6e8a002c7e10a2/timeseries", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQueryNoUser(t, map[string]string{
+			"startAt": "1527811200",
+		}, r)
+
+		b, err := getTestFile(filePath)
+		if err != nil {
+			t.Fatalf("Failed to open testfile %s", filePath)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+	})
+
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	series, _, err := client.VenueGroups.Timeseries(&VenueGroupTimeseriesParams{
+		GroupID: "5b0f2a3c1f6e8a002c7e10a2",
+		StartAt: time.Unix(1527811200, 0),
+	})
+	assert.Nil(t, err)
+
+	assert.Len(t, series, 2)
+	assert.Equal(t, "4a5a4f0ef964a52048b91fe3", series[1].VenueID)
+	assert.Equal(t, 65, series[1].TotalCheckins.Total)
+}
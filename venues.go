@@ -3,6 +3,8 @@ package foursquarego
 import (
 	"encoding/json"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/dghubble/sling"
 )
@@ -77,7 +79,7 @@ type Venue struct {
 	StoreID          string       `json:"storeId"`
 	Page             Page         `json:"page"`
 	HereNow          HereNow      `json:"hereNow"`
-	CreatedAt        int64        `json:"createdAt"`
+	CreatedAt        Timestamp    `json:"createdAt"`
 	Tips             Tips         `json:"tips"`
 	ShortURL         string       `json:"shortUrl"`
 	TimeZone         string       `json:"timeZone"`
@@ -95,6 +97,26 @@ type Venue struct {
 	Colors           Colors       `json:"colors"`
 }
 
+// TimeLocation loads the venue's TimeZone so timestamps such as CreatedAt
+// can be shown in the venue's local time.
+func (v Venue) TimeLocation() (*time.Location, error) {
+	return time.LoadLocation(v.TimeZone)
+}
+
+// PrimaryCategory returns the category marked primary, or the first one
+// when none is. It is nil when the venue has no categories.
+func (v Venue) PrimaryCategory() *Category {
+	for i := range v.Categories {
+		if v.Categories[i].Primary {
+			return &v.Categories[i]
+		}
+	}
+	if len(v.Categories) > 0 {
+		return &v.Categories[0]
+	}
+	return nil
+}
+
 // Contact are details to contact this venue. Can contain all or none.
 type Contact struct {
 	Phone            string `json:"phone"`
@@ -149,6 +171,15 @@ type Icon struct {
 	Suffix string `json:"suffix"`
 }
 
+// URL builds the url of the icon at size pixels, category icons come in
+// 32, 44, 64 and 88. It is empty when the icon is.
+func (i Icon) URL(size int) string {
+	if i.Prefix == "" {
+		return ""
+	}
+	return i.Prefix + strconv.Itoa(size) + i.Suffix
+}
+
 // Stats are the stats for a venue.
 type Stats struct {
 	CheckinsCount int `json:"checkinsCount"`
@@ -254,7 +285,7 @@ type PhotoGrouping struct {
 // https://developer.foursquare.com/docs/api/photos/details
 type Photo struct {
 	ID         string      `json:"id"`
-	CreatedAt  int         `json:"createdAt"`
+	CreatedAt  Timestamp   `json:"createdAt"`
 	Source     PhotoSource `json:"source"`
 	Prefix     string      `json:"prefix"`
 	Suffix     string      `json:"suffix"`
@@ -263,7 +294,10 @@ type Photo struct {
 	Height     int         `json:"height"`
 	User       User        `json:"user"`
 	Visibility string      `json:"visibility"`
-	// Can have a tip or checking associated with it
+	// Venue and Tip are pointers as both hold a Photo.
+	Venue *Venue `json:"venue"`
+	Tip   *Tip   `json:"tip"`
+	// Can have a checkin associated with it
 }
 
 // PhotoSource is the source on a photo struct.
@@ -341,24 +375,25 @@ type TipGroup struct {
 
 // Tip is a foursquare tip on a venue.
 type Tip struct {
-	ID                    string  `json:"id"`
-	CreatedAt             int     `json:"createdAt"`
-	Text                  string  `json:"text"`
-	Type                  string  `json:"type"`
-	URL                   string  `json:"url"`
-	CanonicalURL          string  `json:"canonicalurl"`
-	Photo                 Photo   `json:"photo"`
-	PhotoURL              string  `json:"photoUrl"`
-	Flags                 Omitted `json:"flags"`
-	Likes                 Likes   `json:"likes"`
-	Like                  bool    `json:"like"`
-	LogView               bool    `json:"logView"`
-	Listed                Lists   `json:"listed"`
-	AgreeCount            int     `json:"agreeCount"`
-	DisagreeCount         int     `json:"disagreeCount"`
-	Todo                  Count   `json:"todo"`
-	User                  User    `json:"user"`
-	AuthorInteractionType string  `json:"authorInteractionType"`
+	ID                    string    `json:"id"`
+	CreatedAt             Timestamp `json:"createdAt"`
+	Text                  string    `json:"text"`
+	Type                  string    `json:"type"`
+	URL                   string    `json:"url"`
+	CanonicalURL          string    `json:"canonicalurl"`
+	Photo                 Photo     `json:"photo"`
+	PhotoURL              string    `json:"photoUrl"`
+	Flags                 Omitted   `json:"flags"`
+	Likes                 Likes     `json:"likes"`
+	Like                  bool      `json:"like"`
+	LogView               bool      `json:"logView"`
+	Listed                Lists     `json:"listed"`
+	AgreeCount            int       `json:"agreeCount"`
+	DisagreeCount         int       `json:"disagreeCount"`
+	Todo                  Count     `json:"todo"`
+	User                  User      `json:"user"`
+	Venue                 Venue     `json:"venue"`
+	AuthorInteractionType string    `json:"authorInteractionType"`
 }
 
 // Listed contains a count and the grouped lists
@@ -387,8 +422,8 @@ type List struct {
 	Collaborative bool      `json:"collaborative"`
 	URL           string    `json:"url"`
 	CanonicalURL  string    `json:"canonicalUrl"`
-	CreatedAt     int       `json:"createdAt"`
-	UpdatedAt     int       `json:"updatedAt"`
+	CreatedAt     Timestamp `json:"createdAt"`
+	UpdatedAt     Timestamp `json:"updatedAt"`
 	Photo         Photo     `json:"photo"`
 	LogView       bool      `json:"logView"`
 	GuideType     string    `json:"guideType"`
@@ -406,10 +441,11 @@ type ListItems struct {
 // ListItem contains more information about a list.
 // https://developer.foursquare.com/docs/api/lists/details
 type ListItem struct {
-	ID        string `json:"id"`
-	CreatedAt int    `json:"createdAt"`
-	Tip       Tip    `json:"tip"`
-	Photo     Photo  `json:"photo"`
+	ID        string    `json:"id"`
+	CreatedAt Timestamp `json:"createdAt"`
+	Venue     Venue     `json:"venue"`
+	Tip       Tip       `json:"tip"`
+	Photo     Photo     `json:"photo"`
 }
 
 // Phrase contains a phrase commonly seen with a venue's tips.
@@ -498,3 +534,45 @@ type Color struct {
 	PhotoID string `json:"photoId"`
 	Value   int    `json:"value"`
 }
+
+// TimeSeries is the statistics for a venue between the start and end of a
+// timeseries request. The Sharing and Hours breakdowns are Omitted.
+type TimeSeries struct {
+	VenueID        string        `json:"venueId"`
+	TotalCheckins  Series        `json:"totalCheckins"`
+	NewCheckins    Series        `json:"newCheckins"`
+	UniqueVisitors Series        `json:"uniqueVisitors"`
+	Genders        []SeriesGroup `json:"genders"`
+	Ages           []SeriesGroup `json:"ages"`
+	Sharing        Omitted       `json:"sharing"`
+	Hours          Omitted       `json:"hours"`
+}
+
+// Series is one statistic over time. Each entry in Data is the start of
+// an interval in seconds since the epoch and the value for that interval.
+type Series struct {
+	Total int        `json:"total"`
+	Data  [][2]int64 `json:"data"`
+}
+
+// SeriesGroup is a Series for one part of a breakdown, such as a gender
+// or an age range.
+type SeriesGroup struct {
+	Name string `json:"name"`
+	Series
+}
+
+// SeriesPoint is the value of a Series for the interval starting at Time.
+type SeriesPoint struct {
+	Time  time.Time
+	Value int
+}
+
+// Points returns the Data of the series with the times converted.
+func (s Series) Points() []SeriesPoint {
+	points := make([]SeriesPoint, len(s.Data))
+	for i, d := range s.Data {
+		points[i] = SeriesPoint{Time: time.Unix(d[0], 0), Value: int(d[1])}
+	}
+	return points
+}
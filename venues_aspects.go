@@ -3,6 +3,7 @@ package foursquarego
 import (
 	"encoding/json"
 	"net/http"
+	"time"
 )
 
 // PhotoGroup are the group options on VenueService.Photos
@@ -58,12 +59,29 @@ type Event struct {
 	Categories []Category `json:"categories"`
 	HereNow    HereNow    `json:"hereNow"`
 	AllDay     bool       `json:"allDay"`
-	StartAt    int64      `json:"startAt"`
-	EndAt      int64      `json:"endAt"`
-	Date       int64      `json:"date"`
+	StartAt    Timestamp  `json:"startAt"`
+	EndAt      Timestamp  `json:"endAt"`
+	Date       Timestamp  `json:"date"`
 	TimeZone   string     `json:"timeZone"`
 	Stats      Stats      `json:"stats"`
 	URL        string     `json:"url"`
+	Venue      *Venue     `json:"venue"`
+}
+
+// TimeLocation loads the event's TimeZone so StartAt, EndAt and Date
+// can be shown in the event's local time.
+func (e Event) TimeLocation() (*time.Location, error) {
+	return time.LoadLocation(e.TimeZone)
+}
+
+// LocalStartAt returns StartAt in the event's TimeZone.
+func (e Event) LocalStartAt() (time.Time, error) {
+	return e.StartAt.InZone(e.TimeZone)
+}
+
+// LocalEndAt returns EndAt in the event's TimeZone.
+func (e Event) LocalEndAt() (time.Time, error) {
+	return e.EndAt.InZone(e.TimeZone)
 }
 
 // Events are music and movie events at this venue
@@ -95,9 +113,10 @@ type HoursResp struct {
 // it switches the Days from a string to an array.
 // https://developer.foursquare.com/docs/api/venues/hours
 type HoursTimeFrame struct {
-	Days          []int       `json:"days"`
-	IncludesToday bool        `json:"includesToday"`
-	Open          []HoursOpen `json:"open"`
+	Days          []int          `json:"days"`
+	IncludesToday bool           `json:"includesToday"`
+	Open          []HoursOpen    `json:"open"`
+	Segments      []HoursSegment `json:"segments"`
 }
 
 // HoursOpen contains the start time and end time when the HoursTimeFrame
@@ -108,6 +127,15 @@ type HoursOpen struct {
 	End   string `json:"end"`
 }
 
+// HoursSegment overrides the usual hours of a HoursTimeFrame on a
+// holiday. Date is formatted YYYYMMDD and an empty Open means the venue
+// is closed that day.
+type HoursSegment struct {
+	Label string      `json:"label"`
+	Date  string      `json:"date"`
+	Open  []HoursOpen `json:"open"`
+}
+
 // Hours Returns hours for a venue.
 // https://developer.foursquare.com/docs/api/venues/hours
 func (s *VenueService) Hours(id string) (*VenueHoursResp, *http.Response, error) {
@@ -190,7 +218,11 @@ type ListedGroup string
 
 // Options for a ListedGroup
 const (
-	GroupListedOther ListedGroup = "other"
+	GroupListedOther    ListedGroup = "other"
+	GroupListedCreated  ListedGroup = "created"
+	GroupListedEdited   ListedGroup = "edited"
+	GroupListedFollowed ListedGroup = "followed"
+	GroupListedFriends  ListedGroup = "friends"
 )
 
 // VenueListedParams are the parameters for VenueService.Listed
@@ -358,3 +390,45 @@ func (s *VenueService) Tips(params *VenueTipsParams) ([]Tip, *http.Response, err
 
 	return tipResp.Tips.Items, resp, relevantError(err, *response)
 }
+
+type venueSimilarResp struct {
+	SimilarVenues similarVenues `json:"similarVenues"`
+}
+
+type similarVenues struct {
+	Count int     `json:"count"`
+	Items []Venue `json:"items"`
+}
+
+// Similar returns venues that are similar to the given one
+// https://developer.foursquare.com/docs/api/venues/similar
+func (s *VenueService) Similar(id string) ([]Venue, *http.Response, error) {
+	venues := new(venueSimilarResp)
+	response := new(Response)
+
+	resp, err := s.sling.New().Get(id+"/similar").Receive(response, response)
+	if err == nil {
+		json.Unmarshal(response.Response, venues)
+	}
+
+	return venues.SimilarVenues.Items, resp, relevantError(err, *response)
+}
+
+type venueAttributesResp struct {
+	Attributes Attributes `json:"attributes"`
+}
+
+// Attributes returns the attributes of a venue such as price tier,
+// reservations and parking.
+// https://developer.foursquare.com/docs/api/venues/attributes
+func (s *VenueService) Attributes(id string) (*Attributes, *http.Response, error) {
+	attributes := new(venueAttributesResp)
+	response := new(Response)
+
+	resp, err := s.sling.New().Get(id+"/attributes").Receive(response, response)
+	if err == nil {
+		json.Unmarshal(response.Response, attributes)
+	}
+
+	return &attributes.Attributes, resp, relevantError(err, *response)
+}
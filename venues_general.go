@@ -1,6 +1,7 @@
 package foursquarego
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 )
@@ -51,6 +52,13 @@ type VenueSearchParams struct {
 	URL              string       `url:"url,omitempty"`
 	ProviderID       string       `url:"providerId,omitempty"`
 	LinkedID         int          `url:"linkedId,omitempty"`
+	// Name to Phone describe the place being looked for with IntentMatch.
+	Name    string `url:"name,omitempty"`
+	Address string `url:"address,omitempty"`
+	City    string `url:"city,omitempty"`
+	State   string `url:"state,omitempty"`
+	Zip     string `url:"zip,omitempty"`
+	Phone   string `url:"phone,omitempty"`
 }
 
 type venueSearchResp struct {
@@ -102,10 +110,22 @@ type venueSuggestResp struct {
 // SuggestCompletion returns a list of mini-venues partially matching the search term, near the location.
 // https://developer.foursquare.com/docs/api/venues/suggestcompletion
 func (s *VenueService) SuggestCompletion(params *VenueSuggestParams) ([]MiniVenue, *http.Response, error) {
+	return s.suggestCompletion(context.Background(), params)
+}
+
+// suggestCompletion is SuggestCompletion with a context so a
+// SuggestSession can cancel requests it no longer needs.
+func (s *VenueService) suggestCompletion(ctx context.Context, params *VenueSuggestParams) ([]MiniVenue, *http.Response, error) {
 	venues := new(venueSuggestResp)
 	response := new(Response)
 
-	resp, err := s.sling.New().Get("suggestCompletion").QueryStruct(params).Receive(response, response)
+	sl := s.sling.New().Get("suggestCompletion").QueryStruct(params)
+	req, err := sl.Request()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := sl.Do(req.WithContext(ctx), response, response)
 	if err == nil {
 		json.Unmarshal(response.Response, venues)
 	}
@@ -196,14 +216,14 @@ type VenueExploreParams struct {
 	Limit            int            `url:"limit,omitempty"`
 	Offset           int            `url:"offset,omitempty"`
 	Novelty          Novelty        `url:"novelty,omitempty"`
-	FriendVisits     FriendVisit    `url:"friendVists,omitempty"`
+	FriendVisits     FriendVisit    `url:"friendVisits,omitempty"`
 	Time             ExploreTime    `url:"time,omitempty"`
 	Day              ExploreTime    `url:"day,omitempty"`
 	VenuePhotos      BoolAsAnInt    `url:"venuePhotos,omitempty"`
 	LastVenue        string         `url:"lastVenue,omitempty"`
 	OpenNow          BoolAsAnInt    `url:"openNow,omitempty"`
 	SortByDistance   BoolAsAnInt    `url:"sortByDistance,omitempty"`
-	Price            []int          `url:"price,omitempty"`
+	Price            []int          `url:"price,comma,omitempty"`
 	Saved            BoolAsAnInt    `url:"saved,omitempty"`
 	Specials         BoolAsAnInt    `url:"specials,omitempty"`
 }
@@ -0,0 +1,176 @@
+package foursquarego
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+// VenueManagedParams are the parameters for VenueService.Managed
+type VenueManagedParams struct {
+	Limit  int `url:"limit,omitempty"`
+	Offset int `url:"offset,omitempty"`
+}
+
+// ManagedVenues is the response for VenueService.Managed. Count is the
+// total number of venues the acting user manages.
+type ManagedVenues struct {
+	Count int     `json:"count"`
+	Items []Venue `json:"items"`
+}
+
+type venueManagedResp struct {
+	Venues ManagedVenues `json:"venues"`
+}
+
+// Managed returns the venues the acting user manages.
+// https://developer.foursquare.com/docs/api/venues/managed
+func (s *VenueService) Managed(params *VenueManagedParams) (*ManagedVenues, *http.Response, error) {
+	venues := new(venueManagedResp)
+	response := new(Response)
+
+	resp, err := s.sling.New().Get("managed").QueryStruct(params).Receive(response, response)
+	if err == nil {
+		json.Unmarshal(response.Response, venues)
+	}
+
+	return &venues.Venues, resp, relevantError(err, *response)
+}
+
+// VenueStatsParams are the parameters for VenueService.Stats. Without
+// StartAt and EndAt foursquare returns the stats since the venue was
+// created.
+type VenueStatsParams struct {
+	VenueID string    `url:"-"`
+	StartAt time.Time `url:"startAt,unix,omitempty"`
+	EndAt   time.Time `url:"endAt,unix,omitempty"`
+}
+
+// VenueStats are the statistics a manager can see for their venue.
+type VenueStats struct {
+	TotalCheckins  int          `json:"totalCheckins"`
+	NewCheckins    int          `json:"newCheckins"`
+	UniqueVisitors int          `json:"uniqueVisitors"`
+	Sharing        VenueSharing `json:"sharing"`
+	Genders        []GenderStat `json:"genders"`
+	Ages           []AgeStat    `json:"ages"`
+	Hours          []HourStat   `json:"hours"`
+	TopVisitors    []Visitor    `json:"topVisitors"`
+	RecentVisitors []Visitor    `json:"recentVisitors"`
+}
+
+// VenueSharing is how many checkins were shared to other networks.
+type VenueSharing struct {
+	Twitter  int `json:"twitter"`
+	Facebook int `json:"facebook"`
+}
+
+// GenderStat is the number of checkins by a gender.
+type GenderStat struct {
+	Gender   string `json:"gender"`
+	Checkins int    `json:"checkins"`
+}
+
+// AgeStat is the number of checkins by an age range such as 18-24.
+type AgeStat struct {
+	Age      string `json:"age"`
+	Checkins int    `json:"checkins"`
+}
+
+// HourStat is the number of checkins in an hour of the day.
+type HourStat struct {
+	Hour     int `json:"hour"`
+	Checkins int `json:"checkins"`
+}
+
+// Visitor is a user and how many times they checked in.
+type Visitor struct {
+	User     User `json:"user"`
+	Checkins int  `json:"checkins"`
+}
+
+type venueStatsResp struct {
+	Stats VenueStats `json:"stats"`
+}
+
+// Stats returns the statistics for a venue the acting user manages.
+// https://developer.foursquare.com/docs/api/venues/stats
+func (s *VenueService) Stats(params *VenueStatsParams) (*VenueStats, *http.Response, error) {
+	stats := new(venueStatsResp)
+	response := new(Response)
+
+	resp, err := s.sling.New().Get(params.VenueID+"/stats").QueryStruct(params).Receive(response, response)
+	if err == nil {
+		json.Unmarshal(response.Response, stats)
+	}
+
+	return &stats.Stats, resp, relevantError(err, *response)
+}
+
+// VenueTimeseriesParams are the parameters for VenueService.Timeseries. If
+// EndAt is not set foursquare uses the current time.
+type VenueTimeseriesParams struct {
+	VenueIDs []string          `url:"venueId,comma"`
+	StartAt  time.Time         `url:"startAt,unix"`
+	EndAt    time.Time         `url:"endAt,unix,omitempty"`
+	Fields   []TimeSeriesField `url:"fields,comma,omitempty"`
+}
+
+// Timeseries returns daily stats for venues the acting user manages.
+// https://developer.foursquare.com/docs/api/venues/timeseries
+func (s *VenueService) Timeseries(params *VenueTimeseriesParams) ([]TimeSeries, *http.Response, error) {
+	series := new(timeseriesResp)
+	response := new(Response)
+
+	resp, err := s.sling.New().Get("timeseries").QueryStruct(params).Receive(response, response)
+	if err == nil {
+		json.Unmarshal(response.Response, series)
+	}
+
+	return series.TimeSeries, resp, relevantError(err, *response)
+}
+
+// VenueHereNowParams are the parameters for VenueService.HereNow
+type VenueHereNowParams struct {
+	VenueID string `url:"-"`
+	Limit   int    `url:"limit,omitempty"`
+	Offset  int    `url:"offset,omitempty"`
+}
+
+// VenueHereNow is the response for VenueService.HereNow. Items is only
+// filled in for managers of the venue, everyone else gets the Count and
+// Summary.
+type VenueHereNow struct {
+	Count   int       `json:"count"`
+	Summary string    `json:"summary"`
+	Items   []Checkin `json:"items"`
+}
+
+// Checkin is a user being at a venue.
+type Checkin struct {
+	ID             string    `json:"id"`
+	CreatedAt      Timestamp `json:"createdAt"`
+	Type           string    `json:"type"`
+	Shout          string    `json:"shout"`
+	TimeZoneOffset int       `json:"timeZoneOffset"`
+	User           User      `json:"user"`
+	Venue          *Venue    `json:"venue"`
+}
+
+type venueHereNowResp struct {
+	HereNow VenueHereNow `json:"hereNow"`
+}
+
+// HereNow returns the users currently checked in at a venue.
+// https://developer.foursquare.com/docs/api/venues/herenow
+func (s *VenueService) HereNow(params *VenueHereNowParams) (*VenueHereNow, *http.Response, error) {
+	hereNow := new(venueHereNowResp)
+	response := new(Response)
+
+	resp, err := s.sling.New().Get(params.VenueID+"/herenow").QueryStruct(params).Receive(response, response)
+	if err == nil {
+		json.Unmarshal(response.Response, hereNow)
+	}
+
+	return &hereNow.HereNow, resp, relevantError(err, *response)
+}
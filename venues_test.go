@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -140,7 +141,7 @@ func TestVenueService_Details(t *testing.T) {
 	assert.Equal(t, 735, venue.Photos.Groups[0].Count)
 	assert.Len(t, venue.Photos.Groups[0].Items, 6)
 	assert.Equal(t, "549ecb0f11d2ed4887ba35ab", venue.Photos.Groups[0].Items[0].ID)
-	assert.Equal(t, 1419692815, venue.Photos.Groups[0].Items[0].CreatedAt)
+	assert.Equal(t, int64(1419692815), venue.Photos.Groups[0].Items[0].CreatedAt.Unix())
 	assert.Equal(t, "Foursquare Web", venue.Photos.Groups[0].Items[0].Source.Name)
 	assert.Equal(t, "https://foursquare.com", venue.Photos.Groups[0].Items[0].Source.URL)
 	assert.Equal(t, "https://igx.4sqi.net/img/general/", venue.Photos.Groups[0].Items[0].Prefix)
@@ -181,12 +182,12 @@ func TestVenueService_Details(t *testing.T) {
 	assert.Equal(t, "Other people here", venue.HereNow.Groups[0].Name)
 	assert.Equal(t, 16, venue.HereNow.Groups[0].Count)
 
-	assert.Equal(t, int64(1410650278), venue.CreatedAt)
+	assert.Equal(t, int64(1410650278), venue.CreatedAt.Unix())
 
 	assert.Equal(t, 165, venue.Tips.Count)
 	assert.Len(t, venue.Tips.Groups, 4)
 	assert.Equal(t, "59b7f2dd829b0c4692f0b465", venue.Tips.Groups[2].Items[0].ID)
-	assert.Equal(t, 1505227485, venue.Tips.Groups[2].Items[0].CreatedAt)
+	assert.Equal(t, int64(1505227485), venue.Tips.Groups[2].Items[0].CreatedAt.Unix())
 	assert.Equal(t, "This Gowanus brewpub offers a lovely patio for enjoying its own crafted beers, local brews, and a full bar. Threes almost always has an exciting food pop-up going on, too.", venue.Tips.Groups[2].Items[0].Text)
 	assert.Equal(t, "user", venue.Tips.Groups[2].Items[0].Type)
 	assert.Equal(t, "https://ny.eater.com/maps/best-outdoor-bars-drinking-nyc", venue.Tips.Groups[2].Items[0].URL)
@@ -215,15 +216,15 @@ func TestVenueService_Details(t *testing.T) {
 	assert.Equal(t, false, venue.Listed.Groups[0].Items[0].Collaborative)
 	assert.Equal(t, "/foursquare/list/20-great-spots-for-a-summer-beer-in-nyc", venue.Listed.Groups[0].Items[0].URL)
 	assert.Equal(t, "https://foursquare.com/foursquare/list/20-great-spots-for-a-summer-beer-in-nyc", venue.Listed.Groups[0].Items[0].CanonicalURL)
-	assert.Equal(t, 1467318051, venue.Listed.Groups[0].Items[0].CreatedAt)
-	assert.Equal(t, 1467401782, venue.Listed.Groups[0].Items[0].UpdatedAt)
+	assert.Equal(t, int64(1467318051), venue.Listed.Groups[0].Items[0].CreatedAt.Unix())
+	assert.Equal(t, int64(1467401782), venue.Listed.Groups[0].Items[0].UpdatedAt.Unix())
 	assert.Equal(t, true, venue.Listed.Groups[0].Items[0].LogView)
 	assert.Equal(t, "bestOf", venue.Listed.Groups[0].Items[0].GuideType)
 	assert.Equal(t, true, venue.Listed.Groups[0].Items[0].Guide)
 	assert.Equal(t, 98, venue.Listed.Groups[0].Items[0].Followers.Count)
 	assert.Len(t, venue.Listed.Groups[0].Items[0].ListItems.Items, 1)
 	assert.Equal(t, "t5692caa3498efc71821e8c54", venue.Listed.Groups[0].Items[0].ListItems.Items[0].ID)
-	assert.Equal(t, 1467319289, venue.Listed.Groups[0].Items[0].ListItems.Items[0].CreatedAt)
+	assert.Equal(t, int64(1467319289), venue.Listed.Groups[0].Items[0].ListItems.Items[0].CreatedAt.Unix())
 
 	assert.Len(t, venue.Phrases, 3)
 	assert.Equal(t, "rotating kitchen", venue.Phrases[0].Phrase)
@@ -256,7 +257,7 @@ func TestVenueService_Details(t *testing.T) {
 	assert.Equal(t, 2, venue.Attributes.Groups[0].Items[0].PriceTier)
 
 	assert.Equal(t, "549ecb0f11d2ed4887ba35ab", venue.BestPhoto.ID)
-	assert.Equal(t, 1419692815, venue.BestPhoto.CreatedAt)
+	assert.Equal(t, int64(1419692815), venue.BestPhoto.CreatedAt.Unix())
 	assert.Equal(t, "Foursquare Web", venue.BestPhoto.Source.Name)
 	assert.Equal(t, "https://foursquare.com", venue.BestPhoto.Source.URL)
 	assert.Equal(t, "https://igx.4sqi.net/img/general/", venue.BestPhoto.Prefix)
@@ -302,7 +303,7 @@ func TestVenueService_Photos(t *testing.T) {
 
 	assert.Equal(t, 30, photos.Count)
 	assert.Equal(t, "549ecb0f11d2ed4887ba35ab", photos.Items[0].ID)
-	assert.Equal(t, 1419692815, photos.Items[0].CreatedAt)
+	assert.Equal(t, int64(1419692815), photos.Items[0].CreatedAt.Unix())
 	assert.Equal(t, "Foursquare Web", photos.Items[0].Source.Name)
 	assert.Equal(t, "https://igx.4sqi.net/img/general/", photos.Items[0].Prefix)
 	assert.Equal(t, "/95760005_78vNYkB4sZbQ23LykVYIccyi2zSkD98qo3CHkQ-vI5k.jpg", photos.Items[0].Suffix)
@@ -347,10 +348,13 @@ func TestVenueService_Events(t *testing.T) {
 	assert.Equal(t, "580850f7d67c37ceeeaae676", events.Items[0].ID)
 	assert.Equal(t, "Moonlight", events.Items[0].Name)
 	assert.Equal(t, true, events.Items[0].AllDay)
-	assert.Equal(t, int64(1526675883), events.Items[0].StartAt)
-	assert.Equal(t, int64(1526848682), events.Items[0].EndAt)
-	assert.Equal(t, int64(1477540800), events.Items[0].Date)
+	assert.Equal(t, int64(1526675883), events.Items[0].StartAt.Unix())
+	assert.Equal(t, int64(1526848682), events.Items[0].EndAt.Unix())
+	assert.Equal(t, int64(1477540800), events.Items[0].Date.Unix())
 	assert.Equal(t, "America/New_York", events.Items[0].TimeZone)
+	loc, err := events.Items[0].TimeLocation()
+	assert.Nil(t, err)
+	assert.Equal(t, "2016-10-27 00:00:00 -0400 EDT", events.Items[0].Date.In(loc).String())
 	assert.Equal(t, 81, events.Items[0].Stats.CheckinsCount)
 	assert.Equal(t, 78, events.Items[0].Stats.UsersCount)
 	assert.Equal(t, "https://foursquare.com/events/movies?theater=AAORE&movie=194816&wired=true", events.Items[0].URL)
@@ -775,3 +779,283 @@ func TestVenueService_Tips(t *testing.T) {
 	assert.Len(t, resp, 1)
 	assert.Equal(t, "57f1673c498e128bfb537f04", resp[0].ID)
 }
+
+func TestVenueService_Managed(t *testing.T) {
+	const filePath = "./json/venues/managed.json"
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/v2/venues/managed", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQueryNoUser(t, map[string]string{
+			"limit": "100",
+		}, r)
+
+		b, err := getTestFile(filePath)
+		if err != nil {
+			t.Fatalf("Failed to open testfile %s", filePath)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+	})
+
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	venues, _, err := client.Venues.Managed(&VenueManagedParams{Limit: 100})
+	assert.Nil(t, err)
+
+	assert.Equal(t, 1, venues.Count)
+	assert.Equal(t, "5414d0a6498ea3d31a3c64cf", venues.Items[0].ID)
+	assert.Equal(t, "Threes Brewing", venues.Items[0].Name)
+}
+
+func TestVenueService_Stats(t *testing.T) {
+	const filePath = "./json/venues/stats.json"
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/v2/venues/5414d0a6498ea3d31a3c64cf/stats", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQueryNoUser(t, map[string]string{
+			"startAt": "1527811200",
+			"endAt":   "1528416000",
+		}, r)
+
+		b, err := getTestFile(filePath)
+		if err != nil {
+			t.Fatalf("Failed to open testfile %s", filePath)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+	})
+
+	start := time.Unix(1527811200, 0)
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	stats, _, err := client.Venues.Stats(&VenueStatsParams{
+		VenueID: "5414d0a6498ea3d31a3c64cf",
+		StartAt: start,
+		EndAt:   start.AddDate(0, 0, 7),
+	})
+	assert.Nil(t, err)
+
+	assert.Equal(t, 412, stats.TotalCheckins)
+	assert.Equal(t, 168, stats.NewCheckins)
+	assert.Equal(t, 301, stats.UniqueVisitors)
+	assert.Equal(t, 31, stats.Sharing.Facebook)
+	assert.Equal(t, GenderStat{Gender: "female", Checkins: 176}, stats.Genders[1])
+	assert.Equal(t, AgeStat{Age: "25-34", Checkins: 243}, stats.Ages[1])
+	assert.Equal(t, HourStat{Hour: 19, Checkins: 97}, stats.Hours[2])
+	assert.Equal(t, "349672", stats.TopVisitors[0].User.ID)
+	assert.Equal(t, 9, stats.TopVisitors[0].Checkins)
+	assert.Equal(t, "68150", stats.RecentVisitors[0].User.ID)
+}
+
+func TestVenueService_Timeseries(t *testing.T) {
+	const filePath = "./json/venues/timeseries.json"
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/v2/venues/timeseries", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQueryNoUser(t, map[string]string{
+			"venueId": "5414d0a6498ea3d31a3c64cf",
+			"startAt": "1527811200",
+			"fields":  "totalCheckins,newCheckins,uniqueVisitors,genders,ages",
+		}, r)
+
+		b, err := getTestFile(filePath)
+		if err != nil {
+			t.Fatalf("Failed to open testfile %s", filePath)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+	})
+
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	series, _, err := client.Venues.Timeseries(&VenueTimeseriesParams{
+		VenueIDs: []string{"5414d0a6498ea3d31a3c64cf"},
+		StartAt:  time.Unix(1527811200, 0),
+		Fields: []TimeSeriesField{
+			FieldTotalCheckins,
+			FieldNewCheckins,
+			FieldUniqueVisitors,
+			FieldGenders,
+			FieldAges,
+		},
+	})
+	assert.Nil(t, err)
+
+	assert.Len(t, series, 1)
+	assert.Equal(t, "5414d0a6498ea3d31a3c64cf", series[0].VenueID)
+	assert.Equal(t, 412, series[0].TotalCheckins.Total)
+	assert.Equal(t, 168, series[0].NewCheckins.Total)
+
+	points := series[0].UniqueVisitors.Points()
+	assert.Len(t, points, 7)
+	assert.Equal(t, int64(1527897600), points[1].Time.Unix())
+	assert.Equal(t, 50, points[1].Value)
+
+	assert.Equal(t, "female", series[0].Genders[1].Name)
+	assert.Equal(t, 43, series[0].Genders[1].Points()[4].Value)
+	assert.Equal(t, "25-34", series[0].Ages[1].Name)
+	assert.Equal(t, 243, series[0].Ages[1].Total)
+}
+
+func TestVenueService_HereNow(t *testing.T) {
+	const filePath = "./json/venues/herenow.json"
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/v2/venues/5414d0a6498ea3d31a3c64cf/herenow", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQueryNoUser(t, map[string]string{
+			"limit": "10",
+		}, r)
+
+		b, err := getTestFile(filePath)
+		if err != nil {
+			t.Fatalf("Failed to open testfile %s", filePath)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+	})
+
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	hereNow, _, err := client.Venues.HereNow(&VenueHereNowParams{
+		VenueID: "5414d0a6498ea3d31a3c64cf",
+		Limit:   10,
+	})
+	assert.Nil(t, err)
+
+	assert.Equal(t, 2, hereNow.Count)
+	assert.Len(t, hereNow.Items, 2)
+	assert.Equal(t, "5b10c2a81f6e8a002c8011f0", hereNow.Items[0].ID)
+	assert.Equal(t, int64(1527824040), hereNow.Items[0].CreatedAt.Unix())
+	assert.Equal(t, "Patio is open!", hereNow.Items[0].Shout)
+	assert.Equal(t, -240, hereNow.Items[0].TimeZoneOffset)
+	assert.Equal(t, "Valerie", hereNow.Items[0].User.FirstName)
+}
+
+func TestVenueService_HereNowPublic(t *testing.T) {
+	const filePath = "./json/venues/herenow_public.json"
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/v2/venues/5414d0a6498ea3d31a3c64cf/herenow", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQueryNoUser(t, map[string]string{}, r)
+
+		b, err := getTestFile(filePath)
+		if err != nil {
+			t.Fatalf("Failed to open testfile %s", filePath)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+	})
+
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	hereNow, _, err := client.Venues.HereNow(&VenueHereNowParams{
+		VenueID: "5414d0a6498ea3d31a3c64cf",
+	})
+	assert.Nil(t, err)
+
+	assert.Equal(t, 16, hereNow.Count)
+	assert.Equal(t, "16 people are here", hereNow.Summary)
+	assert.Len(t, hereNow.Items, 0)
+}
+
+func TestVenueService_HoursHoliday(t *testing.T) {
+	const filePath = "./json/venues/hours_holiday.json"
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/v2/venues/5414d0a6498ea3d31a3c64cf/hours", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQueryNoUser(t, map[string]string{}, r)
+
+		b, err := getTestFile(filePath)
+		if err != nil {
+			t.Fatalf("Failed to open testfile %s", filePath)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+	})
+
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	hours, _, err := client.Venues.Hours("5414d0a6498ea3d31a3c64cf")
+	assert.Nil(t, err)
+
+	assert.Len(t, hours.Hours.TimeFrames, 2)
+	assert.Len(t, hours.Hours.TimeFrames[0].Segments, 1)
+	assert.Equal(t, "Christmas Eve", hours.Hours.TimeFrames[0].Segments[0].Label)
+	assert.Equal(t, "20181224", hours.Hours.TimeFrames[0].Segments[0].Date)
+	assert.Equal(t, "1200", hours.Hours.TimeFrames[0].Segments[0].Open[0].Start)
+	assert.Equal(t, "1800", hours.Hours.TimeFrames[0].Segments[0].Open[0].End)
+	assert.Equal(t, "Christmas Day", hours.Hours.TimeFrames[1].Segments[0].Label)
+	assert.Len(t, hours.Hours.TimeFrames[1].Segments[0].Open, 0)
+	assert.Len(t, hours.Popular.TimeFrames[0].Segments, 0)
+}
+
+func TestVenueService_Similar(t *testing.T) {
+	const filePath = "./json/venues/similar.json"
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/v2/venues/5414d0a6498ea3d31a3c64cf/similar", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQueryNoUser(t, map[string]string{}, r)
+
+		b, err := getTestFile(filePath)
+		if err != nil {
+			t.Fatalf("Failed to open testfile %s", filePath)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+	})
+
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	venues, _, err := client.Venues.Similar("5414d0a6498ea3d31a3c64cf")
+	assert.Nil(t, err)
+
+	assert.Len(t, venues, 3)
+	assert.Equal(t, "4e0f7a46fa76b8a7ea8b8a85", venues[0].ID)
+	assert.Equal(t, "Other Half Brewing Co.", venues[0].Name)
+	assert.Equal(t, "Brewery", venues[0].Categories[0].Name)
+	assert.Equal(t, "Brooklyn, NY 11211", venues[2].Location.FormattedAddress[1])
+}
+
+func TestVenueService_Attributes(t *testing.T) {
+	const filePath = "./json/venues/attributes.json"
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/v2/venues/5414d0a6498ea3d31a3c64cf/attributes", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQueryNoUser(t, map[string]string{}, r)
+
+		b, err := getTestFile(filePath)
+		if err != nil {
+			t.Fatalf("Failed to open testfile %s", filePath)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+	})
+
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	attributes, _, err := client.Venues.Attributes("5414d0a6498ea3d31a3c64cf")
+	assert.Nil(t, err)
+
+	assert.Len(t, attributes.Groups, 7)
+	assert.Equal(t, "price", attributes.Groups[0].Type)
+	assert.Equal(t, "$$", attributes.Groups[0].Summary)
+	assert.Equal(t, 2, attributes.Groups[0].Items[0].PriceTier)
+	assert.Equal(t, "wifi", attributes.Groups[4].Type)
+	assert.Equal(t, "Free", attributes.Groups[4].Items[0].DisplayValue)
+}